@@ -17,6 +17,8 @@
 package v1alpha1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/IBM/ibm-licensing-operator/api/v1alpha1/features"
 )
 
@@ -51,6 +53,12 @@ type Features struct {
 	// +optional
 	CustomNamespaceScopeConfigMap *string `json:"nssConfigMap,omitempty"`
 
+	// Special terms, must be granted by IBM Pricing. Namespaces matching the selector are resolved continuously and
+	// published to License Service in a ConfigMap generated by the operator. Takes precedence over nssConfigMap.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace scope selector",xDescriptors="urn:alm:descriptor:com.tectonic.ui:hidden"
+	// +optional
+	NamespaceScopeSelector *metav1.LabelSelector `json:"nssNamespaceSelector,omitempty"`
+
 	// Limit for failed namespace access attempts before reporting an error in custom namespaces scoping.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,xDescriptors="urn:alm:descriptor:com.tectonic.ui:hidden"
 	// +optional
@@ -69,6 +77,10 @@ func (spec *IBMLicensingSpec) IsCustomNamespaceScopeConfigMap() bool {
 	return spec.HaveFeatures() && spec.Features != nil && spec.Features.CustomNamespaceScopeConfigMap != nil
 }
 
+func (spec *IBMLicensingSpec) IsNamespaceScopeSelector() bool {
+	return spec.IsNamespaceScopeEnabled() && spec.Features.NamespaceScopeSelector != nil
+}
+
 func (spec *IBMLicensingSpec) GetCustomNamespaceScopeConfigMap() string {
 	if !spec.IsCustomNamespaceScopeConfigMap() {
 		return ""
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/IBM/ibm-licensing-operator/api/v1alpha1/features"
 
@@ -28,9 +29,11 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
+	defaultTokenOverlapPeriod       = 24 * time.Hour
 	defaultLicensingTokenSecretName = "ibm-licensing-token"                //#nosec
 	defaultReporterTokenSecretName  = "ibm-license-service-reporter-token" // secret used by LS to push data to LSR
 	OperandLicensingImageEnvVar     = "IBM_LICENSING_IMAGE"
@@ -45,6 +48,15 @@ var (
 	ephemeralStorage256Mi = resource.NewQuantity(256*1024*1024, resource.BinarySI)
 )
 
+type IBMLicensingTokenRotation struct {
+	// How often tokens are rotated, f.e. 720h. If not set, tokens are rotated only on demand.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// How long the previous token stays valid after rotation, so that consumers can switch to the new one. Defaults to 24h.
+	// +optional
+	OverlapPeriod *metav1.Duration `json:"overlapPeriod,omitempty"`
+}
+
 type Container struct {
 	// IBM Licensing Service docker Image Registry, will override default value and disable IBM_LICENSING_IMAGE env value in operator deployment
 	ImageRegistry string `json:"imageRegistry,omitempty"`
@@ -85,6 +97,14 @@ type IBMLicenseServiceBaseSpec struct {
 	LogLevel string `json:"logLevel,omitempty"`
 	// Secret name used to store application token, either one that exists, or one that will be created
 	APISecretToken string `json:"apiSecretToken,omitempty"`
+	// Issue a distinct API token for every namespace requesting License Service API access, instead of sharing apiSecretToken.
+	// Token of a namespace is revoked when no OperandRequest or IBMLicensingAccessRequest in that namespace requests it.
+	// +optional
+	ScopedAPITokens bool `json:"scopedAPITokens,omitempty"`
+	// Rotation of API and upload tokens. When set, tokens can also be rotated on demand by changing the value of
+	// operator.ibm.com/licensing-rotate-tokens annotation of the IBMLicensing.
+	// +optional
+	TokenRotation *IBMLicensingTokenRotation `json:"tokenRotation,omitempty"`
 	// Array of pull secrets which should include existing at InstanceNamespace secret to allow pulling IBM Licensing image
 	ImagePullSecrets []string `json:"imagePullSecrets,omitempty"`
 	// options: self-signed or custom
@@ -104,6 +124,32 @@ func (spec *IBMLicensingSpec) GetDefaultReporterTokenName() string {
 	return defaultReporterTokenSecretName
 }
 
+func (spec *IBMLicenseServiceBaseSpec) GetAPISecretTokenName() string {
+	if spec.APISecretToken == "" {
+		return defaultLicensingTokenSecretName
+	}
+	return spec.APISecretToken
+}
+
+func (spec *IBMLicenseServiceBaseSpec) IsTokenRotationEnabled() bool {
+	return spec.TokenRotation != nil
+}
+
+// GetTokenRotationInterval returns interval of scheduled tokens rotation, or 0 when tokens are rotated only on demand
+func (spec *IBMLicenseServiceBaseSpec) GetTokenRotationInterval() time.Duration {
+	if spec.TokenRotation == nil || spec.TokenRotation.Interval == nil {
+		return 0
+	}
+	return spec.TokenRotation.Interval.Duration
+}
+
+func (spec *IBMLicenseServiceBaseSpec) GetTokenOverlapPeriod() time.Duration {
+	if spec.TokenRotation == nil || spec.TokenRotation.OverlapPeriod == nil {
+		return defaultTokenOverlapPeriod
+	}
+	return spec.TokenRotation.OverlapPeriod.Duration
+}
+
 func (spec *IBMLicenseServiceBaseSpec) IsDebug() bool {
 	return spec.LogLevel == "DEBUG"
 }
@@ -309,3 +355,16 @@ func (spec *IBMLicensingSpec) IsSoftwareCentralEnabled() bool {
 	return spec.SoftwareCentral != nil &&
 		spec.SoftwareCentral.Enable
 }
+
+// checks if settings of the OpenShift cluster-wide Proxy should be used for outbound connections
+func (spec *IBMLicensingSpec) IsClusterProxyUsed() bool {
+	return spec.OutboundNetwork == nil || spec.OutboundNetwork.UseClusterProxy == nil || *spec.OutboundNetwork.UseClusterProxy
+}
+
+// returns the priority used to select the active instance, 0 if not set
+func (spec *IBMLicensingSpec) GetPriority() int32 {
+	if spec.Priority == nil {
+		return 0
+	}
+	return *spec.Priority
+}
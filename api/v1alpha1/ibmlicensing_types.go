@@ -61,7 +61,8 @@ type IBMLicensingGatewayOptions struct {
 // +kubebuilder:pruning:PreserveUnknownFields
 type IBMLicensingSpec struct {
 
-	// Environment variable setting
+	// Environment variable setting. Variables take precedence over values computed by the operator from other spec fields,
+	// conflicting or invalid values are reported with the OperandConfigurationValid condition.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Environment variable setting",xDescriptors="urn:alm:descriptor:com.tectonic.ui:hidden"
 	// +optional
 	EnvVariable map[string]string `json:"envVariable,omitempty"`
@@ -141,6 +142,12 @@ type IBMLicensingSpec struct {
 	// +optional
 	SoftwareCentral *IBMLicensingSoftwareCentralSpec `json:"softwareCentral,omitempty"`
 
+	// Proxy and additional trusted certificates used for outbound connections to License Service Reporter,
+	// Software Central and Prometheus query source. On OpenShift, the cluster-wide Proxy is used by default.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Outbound Network",xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	// +optional
+	OutboundNetwork *IBMLicensingOutboundNetworkSpec `json:"outboundNetwork,omitempty"`
+
 	// Set additional features under this field
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Features"
 	// +optional
@@ -159,6 +166,12 @@ type IBMLicensingSpec struct {
 	// Enabling collection of Instana metrics
 	// +optional
 	EnableInstanaMetricCollection bool `json:"enableInstanaMetricCollection,omitempty"`
+
+	// Priority of this instance when more than one IBMLicensing exists, the one with the highest priority becomes active.
+	// Instances annotated with operator.ibm.com/ibmlicensing-active: "true" take precedence regardless of priority.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Priority",xDescriptors="urn:alm:descriptor:com.tectonic.ui:number"
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
 }
 
 type IBMLicensingSoftwareCentralSpec struct {
@@ -184,6 +197,35 @@ type IBMLicensingSoftwareCentralSpec struct {
 	EntitlementKeySecret string `json:"entitlementKeySecret,omitempty"`
 }
 
+type IBMLicensingOutboundNetworkSpec struct {
+	// URL of the proxy used for outbound HTTP connections
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="HTTP Proxy",xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// URL of the proxy used for outbound HTTPS connections
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="HTTPS Proxy",xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	// +optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// Comma-separated list of hosts, domains and CIDRs connected to without the proxy.
+	// In-cluster service domains and localhost are always added.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="No Proxy",xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
+
+	// Name of the ConfigMap in instance namespace with PEM encoded certificates under the ca-bundle.crt key,
+	// trusted for outbound connections in addition to the default ones, f.e. the certificate of a TLS intercepting proxy
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Trusted CA Bundle",xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
+	// +optional
+	TrustedCABundle string `json:"trustedCABundle,omitempty"`
+
+	// Use settings of the OpenShift cluster-wide Proxy for fields not set here (default: true)
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Use Cluster Proxy",xDescriptors="urn:alm:descriptor:com.tectonic.ui:booleanSwitch"
+	// +optional
+	UseClusterProxy *bool `json:"useClusterProxy,omitempty"`
+}
+
 type IBMLicensingSenderSpec struct {
 
 	// URL for License Service Reporter receiver that collects and aggregate multi cluster licensing data.
@@ -211,7 +253,8 @@ type IBMLicensingSenderSpec struct {
 	// +optional
 	ClusterName string `json:"clusterName,omitempty"`
 
-	// Unique ID of reporting cluster
+	// Unique ID of reporting cluster. If not provided, the operator generates a stable ID from OpenShift ClusterVersion
+	// or kube-system namespace and keeps it in ibm-licensing-cluster-id ConfigMap
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster ID",xDescriptors="urn:alm:descriptor:com.tectonic.ui:text"
 	// +optional
 	ClusterID string `json:"clusterID,omitempty"`
@@ -234,6 +277,64 @@ type IBMLicensingStatus struct {
 	// The status of IBM License Service Pods.
 	LicensingPods []corev1.PodStatus         `json:"licensingPods,omitempty"`
 	Features      IBMLicensingFeaturesStatus `json:"features,omitempty"`
+	// Conditions of the IBMLicensing, f.e. why the instance is active or inactive
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// API tokens issued for consumer namespaces, when scopedAPITokens is enabled
+	// +listType=map
+	// +listMapKey=namespace
+	// +optional
+	IssuedAPITokens []IssuedAPIToken `json:"issuedAPITokens,omitempty"`
+	// Result of the last check of connection to License Service Reporter, when sender is configured.
+	// Summary is reported with the SenderReady condition.
+	// +optional
+	Sender *IBMLicensingSenderStatus `json:"sender,omitempty"`
+	// ID of the cluster reported to License Service Reporter, either set in spec.sender.clusterID or generated by the operator
+	// +optional
+	ClusterID string `json:"clusterID,omitempty"`
+	// Schedule of uploads to Software Central, when the integration is enabled.
+	// Summary is reported with the SoftwareCentralReady condition.
+	// +optional
+	SoftwareCentral *IBMLicensingSoftwareCentralStatus `json:"softwareCentral,omitempty"`
+	// Namespaces resolved from spec.features.nssNamespaceSelector. Summary is reported with the NamespaceScopeReady condition.
+	// +optional
+	NamespaceScope *IBMLicensingNamespaceScopeStatus `json:"namespaceScope,omitempty"`
+}
+
+// IBMLicensingNamespaceScopeStatus defines the observed scope of License Service, when namespace scope selector is used
+type IBMLicensingNamespaceScopeStatus struct {
+	// Namespaces matching the selector, published to License Service
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Namespaces from the scope, in which License Service is not permitted to list pods
+	// +optional
+	DeniedNamespaces []string `json:"deniedNamespaces,omitempty"`
+}
+
+// IBMLicensingSoftwareCentralStatus defines the observed state of the Software Central integration
+type IBMLicensingSoftwareCentralStatus struct {
+	// Time of the next scheduled upload, computed from spec.softwareCentral.frequency, empty if the frequency is invalid
+	// +optional
+	NextUploadTime *metav1.Time `json:"nextUploadTime,omitempty"`
+}
+
+// IBMLicensingSenderStatus defines the observed state of connection to License Service Reporter
+type IBMLicensingSenderStatus struct {
+	// Time of the last connectivity check
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+	// Problems found with the reporter secrets or connection, empty when the sender is ready
+	// +optional
+	Diagnostics []string `json:"diagnostics,omitempty"`
+}
+
+// IssuedAPIToken describes API token issued for a consumer namespace
+type IssuedAPIToken struct {
+	// Namespace, which the token is issued for
+	Namespace string `json:"namespace"`
+	// Time of issuing the token
+	CreationTime metav1.Time `json:"creationTime"`
 }
 
 type IBMLicensingFeaturesStatus struct {
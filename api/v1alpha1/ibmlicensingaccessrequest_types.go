@@ -0,0 +1,102 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IBMLicensingAccessBinding defines names of copies of Secret and ConfigMap shared by a binding
+type IBMLicensingAccessBinding struct {
+	// Name of the Secret copy in the namespace of the request. Defaults to ibm-licensing-bindinfo-<source secret name>
+	// +optional
+	Secret string `json:"secret,omitempty"`
+	// Name of the ConfigMap copy in the namespace of the request. Defaults to ibm-licensing-bindinfo-<source configmap name>
+	// +optional
+	ConfigMap string `json:"configmap,omitempty"`
+}
+
+// IBMLicensingAccessRequestSpec defines the desired state of IBMLicensingAccessRequest
+type IBMLicensingAccessRequestSpec struct {
+	// Bindings requested by key, f.e. public-api-data, public-api-token or public-api-upload.
+	// When empty, all bindings are shared with default names.
+	// +optional
+	Bindings map[string]IBMLicensingAccessBinding `json:"bindings,omitempty"`
+}
+
+// IBMLicensingAccessBindingStatus defines the observed state of a single binding
+type IBMLicensingAccessBindingStatus struct {
+	// Key of the binding
+	Key string `json:"key"`
+	// Whether all objects of the binding are copied to the namespace of the request
+	Ready bool `json:"ready"`
+	// Reason of the binding state
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human readable details of the binding state
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// IBMLicensingAccessRequestStatus defines the observed state of IBMLicensingAccessRequest
+type IBMLicensingAccessRequestStatus struct {
+	// Phase of the request, one of Pending, Ready or Failed
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// Generation of the request observed by the operator
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// State of every requested binding
+	// +optional
+	// +listType=map
+	// +listMapKey=key
+	Bindings []IBMLicensingAccessBindingStatus `json:"bindings,omitempty"`
+	// Conditions of the request, f.e. Ready
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=ibmlicensingaccessrequests,scope=Namespaced
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// IBMLicensingAccessRequest requests access to IBM License Service APIs without Operand Deployment Lifecycle Manager.
+// Secrets and ConfigMaps of requested bindings, such as the read-only API token, are copied to the namespace of the request.
+type IBMLicensingAccessRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IBMLicensingAccessRequestSpec   `json:"spec,omitempty"`
+	Status IBMLicensingAccessRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IBMLicensingAccessRequestList contains a list of IBMLicensingAccessRequest
+type IBMLicensingAccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IBMLicensingAccessRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&IBMLicensingAccessRequest{}, &IBMLicensingAccessRequestList{})
+}
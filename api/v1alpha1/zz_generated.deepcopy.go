@@ -37,8 +37,9 @@ package v1alpha1
 
 import (
 	"github.com/IBM/ibm-licensing-operator/api/v1alpha1/features"
-	"github.com/openshift/api/route/v1"
+	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -91,6 +92,11 @@ func (in *Features) DeepCopyInto(out *Features) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NamespaceScopeSelector != nil {
+		in, out := &in.NamespaceScopeSelector, &out.NamespaceScopeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Features.
@@ -106,6 +112,11 @@ func (in *Features) DeepCopy() *Features {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMLicenseServiceBaseSpec) DeepCopyInto(out *IBMLicenseServiceBaseSpec) {
 	*out = *in
+	if in.TokenRotation != nil {
+		in, out := &in.TokenRotation, &out.TokenRotation
+		*out = new(IBMLicensingTokenRotation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
 		*out = make([]string, len(*in))
@@ -133,7 +144,7 @@ func (in *IBMLicenseServiceRouteOptions) DeepCopyInto(out *IBMLicenseServiceRout
 	*out = *in
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
-		*out = new(v1.TLSConfig)
+		*out = new(routev1.TLSConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -175,6 +186,144 @@ func (in *IBMLicensing) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMLicensingAccessBinding) DeepCopyInto(out *IBMLicensingAccessBinding) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingAccessBinding.
+func (in *IBMLicensingAccessBinding) DeepCopy() *IBMLicensingAccessBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMLicensingAccessBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMLicensingAccessBindingStatus) DeepCopyInto(out *IBMLicensingAccessBindingStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingAccessBindingStatus.
+func (in *IBMLicensingAccessBindingStatus) DeepCopy() *IBMLicensingAccessBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMLicensingAccessBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMLicensingAccessRequest) DeepCopyInto(out *IBMLicensingAccessRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingAccessRequest.
+func (in *IBMLicensingAccessRequest) DeepCopy() *IBMLicensingAccessRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMLicensingAccessRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMLicensingAccessRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMLicensingAccessRequestList) DeepCopyInto(out *IBMLicensingAccessRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBMLicensingAccessRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingAccessRequestList.
+func (in *IBMLicensingAccessRequestList) DeepCopy() *IBMLicensingAccessRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMLicensingAccessRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMLicensingAccessRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMLicensingAccessRequestSpec) DeepCopyInto(out *IBMLicensingAccessRequestSpec) {
+	*out = *in
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make(map[string]IBMLicensingAccessBinding, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingAccessRequestSpec.
+func (in *IBMLicensingAccessRequestSpec) DeepCopy() *IBMLicensingAccessRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMLicensingAccessRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMLicensingAccessRequestStatus) DeepCopyInto(out *IBMLicensingAccessRequestStatus) {
+	*out = *in
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]IBMLicensingAccessBindingStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingAccessRequestStatus.
+func (in *IBMLicensingAccessRequestStatus) DeepCopy() *IBMLicensingAccessRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMLicensingAccessRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMLicensingFeaturesStatus) DeepCopyInto(out *IBMLicensingFeaturesStatus) {
 	*out = *in
@@ -373,6 +522,51 @@ func (in *IBMLicensingMetadataStatus) DeepCopy() *IBMLicensingMetadataStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMLicensingNamespaceScopeStatus) DeepCopyInto(out *IBMLicensingNamespaceScopeStatus) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedNamespaces != nil {
+		in, out := &in.DeniedNamespaces, &out.DeniedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingNamespaceScopeStatus.
+func (in *IBMLicensingNamespaceScopeStatus) DeepCopy() *IBMLicensingNamespaceScopeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMLicensingNamespaceScopeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMLicensingOutboundNetworkSpec) DeepCopyInto(out *IBMLicensingOutboundNetworkSpec) {
+	*out = *in
+	if in.UseClusterProxy != nil {
+		in, out := &in.UseClusterProxy, &out.UseClusterProxy
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingOutboundNetworkSpec.
+func (in *IBMLicensingOutboundNetworkSpec) DeepCopy() *IBMLicensingOutboundNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMLicensingOutboundNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMLicensingSecurityContext) DeepCopyInto(out *IBMLicensingSecurityContext) {
 	*out = *in
@@ -403,6 +597,27 @@ func (in *IBMLicensingSenderSpec) DeepCopy() *IBMLicensingSenderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMLicensingSenderStatus) DeepCopyInto(out *IBMLicensingSenderStatus) {
+	*out = *in
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+	if in.Diagnostics != nil {
+		in, out := &in.Diagnostics, &out.Diagnostics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingSenderStatus.
+func (in *IBMLicensingSenderStatus) DeepCopy() *IBMLicensingSenderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMLicensingSenderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMLicensingSoftwareCentralSpec) DeepCopyInto(out *IBMLicensingSoftwareCentralSpec) {
 	*out = *in
@@ -418,6 +633,25 @@ func (in *IBMLicensingSoftwareCentralSpec) DeepCopy() *IBMLicensingSoftwareCentr
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMLicensingSoftwareCentralStatus) DeepCopyInto(out *IBMLicensingSoftwareCentralStatus) {
+	*out = *in
+	if in.NextUploadTime != nil {
+		in, out := &in.NextUploadTime, &out.NextUploadTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingSoftwareCentralStatus.
+func (in *IBMLicensingSoftwareCentralStatus) DeepCopy() *IBMLicensingSoftwareCentralStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMLicensingSoftwareCentralStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMLicensingSpec) DeepCopyInto(out *IBMLicensingSpec) {
 	*out = *in
@@ -480,6 +714,11 @@ func (in *IBMLicensingSpec) DeepCopyInto(out *IBMLicensingSpec) {
 		*out = new(IBMLicensingSoftwareCentralSpec)
 		**out = **in
 	}
+	if in.OutboundNetwork != nil {
+		in, out := &in.OutboundNetwork, &out.OutboundNetwork
+		*out = new(IBMLicensingOutboundNetworkSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Features != nil {
 		in, out := &in.Features, &out.Features
 		*out = new(Features)
@@ -499,6 +738,11 @@ func (in *IBMLicensingSpec) DeepCopyInto(out *IBMLicensingSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingSpec.
@@ -522,6 +766,35 @@ func (in *IBMLicensingStatus) DeepCopyInto(out *IBMLicensingStatus) {
 		}
 	}
 	in.Features.DeepCopyInto(&out.Features)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IssuedAPITokens != nil {
+		in, out := &in.IssuedAPITokens, &out.IssuedAPITokens
+		*out = make([]IssuedAPIToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sender != nil {
+		in, out := &in.Sender, &out.Sender
+		*out = new(IBMLicensingSenderStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SoftwareCentral != nil {
+		in, out := &in.SoftwareCentral, &out.SoftwareCentral
+		*out = new(IBMLicensingSoftwareCentralStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceScope != nil {
+		in, out := &in.NamespaceScope, &out.NamespaceScope
+		*out = new(IBMLicensingNamespaceScopeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingStatus.
@@ -534,6 +807,47 @@ func (in *IBMLicensingStatus) DeepCopy() *IBMLicensingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMLicensingTokenRotation) DeepCopyInto(out *IBMLicensingTokenRotation) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OverlapPeriod != nil {
+		in, out := &in.OverlapPeriod, &out.OverlapPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMLicensingTokenRotation.
+func (in *IBMLicensingTokenRotation) DeepCopy() *IBMLicensingTokenRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMLicensingTokenRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuedAPIToken) DeepCopyInto(out *IssuedAPIToken) {
+	*out = *in
+	in.CreationTime.DeepCopyInto(&out.CreationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuedAPIToken.
+func (in *IssuedAPIToken) DeepCopy() *IssuedAPIToken {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuedAPIToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *License) DeepCopyInto(out *License) {
 	*out = *in
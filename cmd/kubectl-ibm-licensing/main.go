@@ -0,0 +1,127 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+/*
+kubectl-ibm-licensing is a kubectl plugin for common operations on License Service. Install it by placing the binary
+on PATH, then run f.e. `kubectl ibm-licensing status`.
+*/
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	"github.com/IBM/ibm-licensing-operator/pkg/kubectlplugin"
+)
+
+const usage = `Usage: kubectl ibm-licensing [flags] <command>
+
+Commands:
+  status        Show state, pods and conditions of the IBMLicensing instance
+  url           Print URLs of License Service API
+  token         Print License Service API token
+  rotate-tokens Trigger rotation of API and upload tokens
+  rotate-certs  Regenerate certificates created by the operator or OpenShift and restart License Service
+  restart       Perform rolling restart of License Service
+  pause         Pause reconciliation of the IBMLicensing instance by the operator
+  resume        Resume reconciliation of the IBMLicensing instance by the operator
+
+Flags:
+`
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(operatorv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(routev1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.Install(scheme))
+}
+
+func main() {
+	flags := flag.NewFlagSet("kubectl-ibm-licensing", flag.ExitOnError)
+	kubeconfig := flags.String("kubeconfig", "", "Path to the kubeconfig file. KUBECONFIG or ~/.kube/config is used if empty.")
+	namespace := flags.String("namespace", "ibm-licensing", "Namespace of the operator, used for instances without instanceNamespace.")
+	instanceName := flags.String("instance", "", "Name of the IBMLicensing instance. The active instance is used if empty.")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(os.Args[1:])
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	var restConfig *rest.Config
+	var err error
+	if *kubeconfig != "" {
+		restConfig, err = clientcmd.BuildConfigFromFlags("", *kubeconfig)
+	} else {
+		restConfig, err = ctrl.GetConfig()
+	}
+	if err != nil {
+		exitOnError(fmt.Errorf("failed to load kubeconfig: %w", err))
+	}
+	k8sClient, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		exitOnError(fmt.Errorf("failed to create client: %w", err))
+	}
+
+	plugin := &kubectlplugin.Plugin{
+		Client:            k8sClient,
+		Out:               os.Stdout,
+		OperatorNamespace: *namespace,
+		InstanceName:      *instanceName,
+	}
+	commands := map[string]func(context.Context) error{
+		"status":        plugin.Status,
+		"url":           plugin.URL,
+		"token":         plugin.Token,
+		"rotate-tokens": plugin.RotateTokens,
+		"rotate-certs":  plugin.RotateCertificates,
+		"restart":       plugin.Restart,
+		"pause":         func(ctx context.Context) error { return plugin.SetPaused(ctx, true) },
+		"resume":        func(ctx context.Context) error { return plugin.SetPaused(ctx, false) },
+	}
+	command, found := commands[flags.Arg(0)]
+	if !found {
+		fmt.Fprintf(os.Stderr, "Unknown command %s\n\n", flags.Arg(0))
+		flags.Usage()
+		os.Exit(2)
+	}
+	exitOnError(command(context.Background()))
+}
+
+func exitOnError(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,360 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+	svcres "github.com/IBM/ibm-licensing-operator/controllers/resources/service"
+)
+
+var operandBindInfoInfix, _ = regexp.Compile(`^(.*)(opbi|bindinfo)(.*)$`)
+
+/*
+bindingCopier copies Secrets and ConfigMaps shared by bindings from operator namespace to namespace of the requesting object,
+which becomes the controller of the copies. It is used by OperandRequest and IBMLicensingAccessRequest controllers.
+*/
+type bindingCopier struct {
+	client.Client
+	client.Reader
+	Scheme            *runtime.Scheme
+	OperatorNamespace string
+	// Name of the ConfigMap in operator namespace with additional bindings under bindings.yaml key
+	BindingsConfigMap string
+}
+
+// Result of copying objects of a single binding
+type bindingResult struct {
+	Ready   bool
+	Reason  string
+	Message string
+}
+
+/*
+Copies all Secrets and ConfigMaps to namespace of the owner and removes stale copies.
+Returns result for every binding key, with a binding reported as not ready if any of its copies failed.
+*/
+func (r *bindingCopier) copyBindings(ctx context.Context, reqLogger logr.Logger, owner client.Object,
+	bindingCopies []svcres.BindingCopy) (results map[string]bindingResult, failed bool, requeue bool) {
+	results = map[string]bindingResult{}
+	scopedTokensInstance, err := r.getScopedTokensInstance(ctx)
+	if err != nil {
+		reqLogger.Error(err, "Cannot check if scoped API tokens are enabled")
+		return results, true, false
+	}
+	for _, bindingCopy := range bindingCopies {
+		var requeueCopy bool
+		var err error
+		if bindingCopy.Kind == svcres.SecretKind && scopedTokensInstance != nil &&
+			bindingCopy.Source == scopedTokensInstance.Spec.GetAPISecretTokenName() {
+			requeueCopy, err = r.copyConsumerToken(ctx, reqLogger, scopedTokensInstance, bindingCopy, owner)
+		} else if bindingCopy.Kind == svcres.SecretKind {
+			requeueCopy, err = r.copySecret(ctx, reqLogger, bindingCopy.Source, bindingCopy.Target, r.OperatorNamespace, owner.GetNamespace(), bindingCopy.Key, owner)
+		} else {
+			requeueCopy, err = r.copyConfigMap(ctx, reqLogger, bindingCopy.Source, bindingCopy.Target, r.OperatorNamespace, owner.GetNamespace(), bindingCopy.Key, owner)
+		}
+
+		result := bindingResult{Ready: true, Reason: "Copied", Message: fmt.Sprintf("Binding %s is shared", bindingCopy.Key)}
+		if err != nil {
+			reqLogger.Error(err, "Cannot copy "+bindingCopy.Kind, "name", bindingCopy.Source, "namespace", owner.GetNamespace())
+			result = bindingResult{Reason: "CopyFailed", Message: fmt.Sprintf("%s %s could not be copied to %s: %v", bindingCopy.Kind, bindingCopy.Source, bindingCopy.Target, err)}
+			failed = true
+		} else if requeueCopy {
+			result = bindingResult{Reason: "SourceNotFound", Message: fmt.Sprintf("%s %s not found in operator namespace", bindingCopy.Kind, bindingCopy.Source)}
+			requeue = true
+		}
+		if previous, found := results[bindingCopy.Key]; !found || previous.Ready {
+			results[bindingCopy.Key] = result
+		}
+	}
+
+	if err := r.deleteStaleBindingCopies(ctx, reqLogger, owner, bindingCopies); err != nil {
+		failed = true
+	}
+	return results, failed, requeue
+}
+
+// Returns bindings sharing Secret or ConfigMap with given name from operator namespace
+func (r *bindingCopier) getBindingsUsingSource(ctx context.Context, kind string, source client.Object) ([]svcres.Binding, error) {
+	if source.GetNamespace() != r.OperatorNamespace {
+		return nil, nil
+	}
+	bindings, err := r.getBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var usingSource []svcres.Binding
+	for _, binding := range bindings {
+		// Creation or deletion of consumer tokens secret switches API token copies between scoped and shared tokens
+		if kind == svcres.SecretKind && source.GetName() == svcres.ConsumerTokensSecretName && binding.Secret != "" {
+			usingSource = append(usingSource, binding)
+			continue
+		}
+		if (kind == svcres.SecretKind && binding.Secret == source.GetName()) || (kind == svcres.ConfigMapKind && binding.ConfigMap == source.GetName()) {
+			usingSource = append(usingSource, binding)
+		}
+	}
+	return usingSource, nil
+}
+
+// Returns default bindings merged with the ones configured in BindingsConfigMap from operator namespace
+func (r *bindingCopier) getBindings(ctx context.Context) ([]svcres.Binding, error) {
+	if r.BindingsConfigMap == "" {
+		return svcres.DefaultBindings(), nil
+	}
+	bindingsConfigMap := corev1.ConfigMap{}
+	if err := r.Reader.Get(ctx, types.NamespacedName{Namespace: r.OperatorNamespace, Name: r.BindingsConfigMap}, &bindingsConfigMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return svcres.DefaultBindings(), nil
+		}
+		return nil, err
+	}
+	return svcres.MergeBindings(svcres.DefaultBindings(), []byte(bindingsConfigMap.Data[svcres.BindingsConfigMapKey]))
+}
+
+/*
+Deletes Secrets and ConfigMaps previously copied for the owner, which are not expected anymore,
+e.g. because the binding was removed from the owner or its target name changed.
+*/
+func (r *bindingCopier) deleteStaleBindingCopies(ctx context.Context, reqLogger logr.Logger, owner client.Object,
+	bindingCopies []svcres.BindingCopy) error {
+	expected := map[string]bool{}
+	for _, bindingCopy := range bindingCopies {
+		expected[bindingCopy.Kind+"/"+bindingCopy.Target] = true
+	}
+
+	listOpts := []client.ListOption{
+		client.InNamespace(owner.GetNamespace()),
+		client.HasLabels{svcres.BindingKeyLabel},
+	}
+	secrets := corev1.SecretList{}
+	if err := r.Reader.List(ctx, &secrets, listOpts...); err != nil {
+		reqLogger.Error(err, "Cannot list copied Secrets", "namespace", owner.GetNamespace())
+		return err
+	}
+	configMaps := corev1.ConfigMapList{}
+	if err := r.Reader.List(ctx, &configMaps, listOpts...); err != nil {
+		reqLogger.Error(err, "Cannot list copied ConfigMaps", "namespace", owner.GetNamespace())
+		return err
+	}
+
+	var stale []client.Object
+	for i := range secrets.Items {
+		if !expected[svcres.SecretKind+"/"+secrets.Items[i].Name] && metav1.IsControlledBy(&secrets.Items[i], owner) {
+			stale = append(stale, &secrets.Items[i])
+		}
+	}
+	for i := range configMaps.Items {
+		if !expected[svcres.ConfigMapKind+"/"+configMaps.Items[i].Name] && metav1.IsControlledBy(&configMaps.Items[i], owner) {
+			stale = append(stale, &configMaps.Items[i])
+		}
+	}
+
+	for _, object := range stale {
+		reqLogger.Info("Deleting stale copy of binding", "name", object.GetName(), "binding", object.GetLabels()[svcres.BindingKeyLabel])
+		if err := r.Client.Delete(ctx, object); err != nil && !apierrors.IsNotFound(err) {
+			reqLogger.Error(err, "Cannot delete stale copy of binding", "name", object.GetName(), "namespace", object.GetNamespace())
+			return err
+		}
+	}
+	return nil
+}
+
+// Copy secret `sourceName` from source namespace `sourceNs` to target namespace `targetNs`
+func (r *bindingCopier) copySecret(ctx context.Context, reqLogger logr.Logger, sourceName, targetName, sourceNs, targetNs, bindingKey string,
+	requestInstance client.Object) (requeue bool, err error) {
+	if sourceName == "" || sourceNs == "" || targetNs == "" {
+		return false, nil
+	}
+
+	if sourceName == targetName && sourceNs == targetNs {
+		return false, nil
+	}
+
+	if targetName == "" {
+		targetName = res.LsBindInfoName + "-" + sourceName
+	}
+
+	secret := corev1.Secret{}
+	// Use Reader (bypasses label-filtered cache) because source secrets may not carry
+	// the "release=ibm-licensing-service" label required by the ByObject cache.
+	if err := r.Reader.Get(ctx, types.NamespacedName{Name: sourceName, Namespace: sourceNs}, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			reqLogger.Info("Secret not found", "name", sourceName, "namespace", sourceNs)
+			return true, nil
+		}
+		reqLogger.Error(err, "failed to get Secret", "name", sourceName, "namespace", sourceNs)
+		return false, err
+	}
+	// Create the Secret in the requesting object namespace
+	secretLabel := make(map[string]string)
+	// Copy from the original labels to the target labels
+	for k, v := range secret.Labels {
+		if operandBindInfoInfix.MatchString(k) {
+			continue
+		}
+		secretLabel[k] = v
+	}
+	secretLabel[svcres.BindingKeyLabel] = bindingKey
+
+	secretAnnotations := maps.Clone(secret.Annotations)
+	if secretAnnotations == nil {
+		secretAnnotations = map[string]string{}
+	}
+	secretAnnotations[svcres.SourceRevisionAnnotation] = svcres.GetSourceRevision(secret.Data, secret.StringData)
+
+	secretCopy := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        targetName,
+			Namespace:   targetNs,
+			Labels:      secretLabel,
+			Annotations: secretAnnotations,
+		},
+		Type:       secret.Type,
+		Data:       secret.Data,
+		StringData: secret.StringData,
+	}
+	// Set the requesting object as the controller of the Secret
+	if err := controllerutil.SetControllerReference(requestInstance, &secretCopy, r.Scheme); err != nil {
+		reqLogger.Error(err, "failed to set owner of Secret", "OwnerName", requestInstance.GetName(), "SecretName", secretCopy.Name, "namespace", targetNs)
+		return false, err
+	}
+
+	if err := r.Create(ctx, &secretCopy); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			// If already exist, update the Secret
+			existingSecret := corev1.Secret{}
+			// Use Reader (bypasses label-filtered cache) because the target secret may not yet
+			// carry the "release=ibm-licensing-service" label required by the ByObject cache.
+			if err := r.Reader.Get(ctx, types.NamespacedName{Namespace: targetNs, Name: targetName}, &existingSecret); err != nil {
+				reqLogger.Error(err, "failed to get Secret", "name", targetName, "namespace", targetNs)
+				return false, err
+			}
+			// Update existing Secret only if it has same name and set of labels
+			if needUpdate := !res.CompareSecretsData(&secretCopy, &existingSecret) ||
+				existingSecret.Annotations[svcres.SourceRevisionAnnotation] != secretCopy.Annotations[svcres.SourceRevisionAnnotation]; needUpdate {
+				if err := r.Update(ctx, &secretCopy); err != nil {
+					reqLogger.Error(err, "failed to update Secret", "name", targetName, "namespace", targetNs)
+					return false, err
+				}
+			}
+		} else {
+			reqLogger.Error(err, "failed to create Secret", "name", targetName, "namespace", targetNs)
+			return false, err
+		}
+	}
+
+	return false, nil
+}
+
+// Copy configmap `sourceName` from namespace `sourceNs` to namespace `targetNs`
+// and rename it to `targetName`
+func (r *bindingCopier) copyConfigMap(ctx context.Context, reqLogger logr.Logger, sourceName, targetName, sourceNs, targetNs, bindingKey string,
+	requestInstance client.Object) (requeue bool, err error) {
+	if sourceName == "" || sourceNs == "" || targetNs == "" {
+		return false, nil
+	}
+
+	if sourceName == targetName && sourceNs == targetNs {
+		return false, nil
+	}
+
+	if targetName == "" {
+		targetName = res.LsBindInfoName + "-" + sourceName
+	}
+
+	cm := corev1.ConfigMap{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: sourceName, Namespace: sourceNs}, &cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			reqLogger.Info("Configmap not found", "name", sourceName, "namespace", sourceNs)
+			return true, nil
+		}
+		reqLogger.Error(err, "failed to get ConfigMap", "name", sourceName, "namespace", sourceNs)
+		return false, err
+	}
+	// Create the ConfigMap in the requesting object namespace
+	cmLabel := make(map[string]string)
+	// Copy from the original labels to the target labels
+	for k, v := range cm.Labels {
+		if operandBindInfoInfix.MatchString(k) {
+			continue
+		}
+		cmLabel[k] = v
+	}
+	cmLabel[svcres.BindingKeyLabel] = bindingKey
+
+	cmAnnotations := maps.Clone(cm.Annotations)
+	if cmAnnotations == nil {
+		cmAnnotations = map[string]string{}
+	}
+	cmAnnotations[svcres.SourceRevisionAnnotation] = svcres.GetSourceRevision(cm.BinaryData, cm.Data)
+
+	cmCopy := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        targetName,
+			Namespace:   targetNs,
+			Labels:      cmLabel,
+			Annotations: cmAnnotations,
+		},
+		Data:       cm.Data,
+		BinaryData: cm.BinaryData,
+	}
+	// Set the requesting object as the controller of the configmap
+	if err := controllerutil.SetControllerReference(requestInstance, &cmCopy, r.Scheme); err != nil {
+		reqLogger.Error(err, "failed to set owner of ConfigMap", "OwnerName", requestInstance.GetName(), "ConfigMapName", cmCopy.Name, "namespace", targetNs)
+		return false, err
+	}
+
+	// Create the ConfigMap in the requesting object namespace
+	if err := r.Create(ctx, &cmCopy); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			// If already exist, update the ConfigMap
+			existingCm := corev1.ConfigMap{}
+			// Use Reader, as the manager cache does not include namespaces of IBMLicensingAccessRequests outside of watched namespaces
+			if err := r.Reader.Get(ctx, types.NamespacedName{Namespace: targetNs, Name: targetName}, &existingCm); err != nil {
+				reqLogger.Error(err, "failed to get ConfigMap", "name", targetName, "namespace", targetNs)
+				return false, err
+			}
+			// Update existing ConfigMap only if it has same name and set of labels
+			if needUpdate := !res.CompareConfigMapData(&existingCm, &cmCopy) ||
+				existingCm.Annotations[svcres.SourceRevisionAnnotation] != cmCopy.Annotations[svcres.SourceRevisionAnnotation]; needUpdate {
+				if err := r.Update(ctx, &cmCopy); err != nil {
+					reqLogger.Error(err, "failed to update ConfigMap", "name", targetName, "namespace", targetNs)
+					return false, err
+				}
+			}
+		} else {
+			reqLogger.Error(err, "failed to create ConfigMap", "name", targetName, "namespace", targetNs)
+			return false, err
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,214 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	operatorframeworkv1 "github.com/operator-framework/api/pkg/operators/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/api/v1alpha1"
+)
+
+// Names of CustomResourceDefinitions of optional APIs, which change behaviour of the operator when installed or removed
+var capabilityCRDNames = map[string]bool{
+	"operandrequests.operator.ibm.com":             true,
+	"operandbindinfos.operator.ibm.com":            true,
+	"gateways.gateway.networking.k8s.io":           true,
+	"httproutes.gateway.networking.k8s.io":         true,
+	"backendtlspolicies.gateway.networking.k8s.io": true,
+	"meterdefinitions.marketplace.redhat.com":      true,
+	"servicecas.operator.openshift.io":             true,
+}
+
+// All CRD events are mapped to the same request, as every reconciliation checks all capabilities
+var capabilityRequest = reconcile.Request{NamespacedName: types.NamespacedName{Name: "cluster-capabilities"}}
+
+// Controller, which is not added to the manager, but started and stopped at runtime
+type runtimeController interface {
+	// Returns the controller together with other runnables it depends on, f.e. its dedicated cache
+	newRunnables(ctx context.Context, mgr ctrl.Manager) ([]manager.Runnable, error)
+}
+
+/*
+CapabilityReconciler watches CustomResourceDefinitions of optional APIs (ODLM, Gateway API, RHMP, OpenShift)
+and applies their installation or removal at runtime, without restarting the operator:
+cluster capabilities are refreshed (also periodically, every capabilitiesResyncInterval of the operator configuration), watches of owned resources are added to IBMLicensing controller,
+IBMLicensing instances are requeued and OperandRequest controllers are started or stopped.
+*/
+type CapabilityReconciler struct {
+	client.Client
+	client.Reader
+	Log               logr.Logger
+	OperatorNamespace string
+	Capabilities      *res.ClusterCapabilities
+	// Operator configuration with the interval of rechecking the capabilities, which are not provided by CRDs
+	// (e.g. OpenShift routes), defaults are used if not set
+	Config *res.OperatorConfigStore
+
+	IBMLicensingReconciler            *IBMLicensingReconciler
+	OperandRequestReconciler          *OperandRequestReconciler
+	OperandRequestDiscoveryReconciler *OperandRequestDiscoveryReconciler
+	OperatorGroupCoordinator          *OperatorGroupCoordinator
+	// Progress of background tasks started by the reconciler, reported for the liveness check
+	Heartbeats *res.Heartbeats
+
+	mgr ctrl.Manager
+	// Context of the capability controller, cancelled on manager shutdown
+	ctx context.Context
+	// Controllers and tasks started when OperandRequest CRD was found
+	odlmRunnables runnableGroup
+}
+
+// +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
+
+func (r *CapabilityReconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	capabilitiesChanged, err := r.Capabilities.Refresh(ctx)
+	if err != nil {
+		r.Log.Error(err, "Error during checking K8s API")
+		return reconcile.Result{}, err
+	}
+
+	if err := r.IBMLicensingReconciler.UpdateOwnedWatches(ctx); err != nil {
+		r.Log.Error(err, "Could not update watches of IBMLicensing controller")
+		return reconcile.Result{}, err
+	}
+
+	operandRequestCRDExists, err := res.DoesCRDExist(r.Reader, &odlm.OperandRequestList{})
+	if err != nil {
+		r.Log.Error(err, "An error occurred while checking for OperandRequest CRD existence")
+		return reconcile.Result{}, err
+	}
+	if operandRequestCRDExists && !r.odlmRunnables.IsRunning() {
+		r.Log.Info("OperandRequest CRD found on cluster. Starting OperandRequest controllers")
+		if err := r.startODLMControllers(ctx); err != nil {
+			return reconcile.Result{}, err
+		}
+	} else if !operandRequestCRDExists && r.odlmRunnables.IsRunning() {
+		r.Log.Info("OperandRequest CRD removed from cluster. Stopping OperandRequest controllers")
+		r.odlmRunnables.Stop()
+		if err := r.mgr.GetCache().RemoveInformer(ctx, &odlm.OperandRequest{}); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if capabilitiesChanged {
+		r.Log.Info("Cluster capabilities changed. Requeueing IBMLicensing instances")
+		if err := r.IBMLicensingReconciler.RequeueAllInstances(ctx); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.Get().CapabilitiesResyncInterval.Duration}, nil
+}
+
+/*
+Starts OperandRequest controller and, if the operator is allowed to modify its OperatorGroup,
+operandrequest-discovery controller together with the task of removing stale namespaces from OperatorGroup.
+*/
+func (r *CapabilityReconciler) startODLMControllers(ctx context.Context) error {
+	controllers := []runtimeController{r.OperandRequestReconciler}
+
+	operatorGroupCRDExists := false
+	// In Cloud Pak 2.0/3.0 coexistence scenario, License Service Operator 4.x.x leverages Namespace Scope Operator and must not modify OperatorGroup.
+	isNssActive, err := res.IsNamespaceScopeOperatorAvailable(ctx, r.Reader, r.OperatorNamespace)
+	if err != nil {
+		r.Log.Error(err, "Error occurred while detecting Namespace Scope Operator")
+	}
+	if isNssActive {
+		r.Log.Info("Namespace Scope ConfigMap detected. operandrequest-discovery disabled")
+	} else {
+		// On clusters without OLM installed, skip both operandrequest-discovery and the stale-namespace cleanup task,
+		// otherwise every run produces a "no matches for kind OperatorGroup" error
+		operatorGroupCRDExists, err = res.DoesCRDExist(r.Reader, &operatorframeworkv1.OperatorGroupList{})
+		if err != nil {
+			r.Log.Error(err, "An error occurred while checking for OperatorGroup CRD existence. operandrequest-discovery and operatorgroup-namespaces-watcher will not be started")
+		}
+		if operatorGroupCRDExists {
+			controllers = append(controllers, r.OperandRequestDiscoveryReconciler)
+		} else {
+			r.Log.Info("OperatorGroup CRD not found in cluster. operandrequest-discovery and operatorgroup-namespaces-watcher disabled")
+		}
+	}
+
+	var runnables []manager.Runnable
+	for _, runtimeController := range controllers {
+		controllerRunnables, err := runtimeController.newRunnables(r.ctx, r.mgr)
+		if err != nil {
+			return err
+		}
+		runnables = append(runnables, controllerRunnables...)
+	}
+	if operatorGroupCRDExists {
+		runnables = append(runnables, r.OperatorGroupCoordinator, &StaleNamespacesCleaner{
+			Reader:      r.Reader,
+			Log:         ctrl.Log.WithName("operatorgroup-namespaces-watcher"),
+			Coordinator: r.OperatorGroupCoordinator,
+			Heartbeats:  r.Heartbeats,
+		})
+	}
+
+	r.odlmRunnables.Run(r.ctx, runnables...)
+	return nil
+}
+
+func (r *CapabilityReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.mgr = mgr
+	// Manager waits for the controllers started at runtime to stop on shutdown
+	r.odlmRunnables.Log = r.Log
+	if err := mgr.Add(&r.odlmRunnables); err != nil {
+		return err
+	}
+
+	crdMetadata := &metav1.PartialObjectMetadata{}
+	crdMetadata.SetGroupVersionKind(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("capabilities").
+		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
+		Watches(crdMetadata,
+			handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+				return []reconcile.Request{capabilityRequest}
+			}),
+			builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return capabilityCRDNames[obj.GetName()]
+			}))).
+		// Sources are started with the controller's context before any reconciliation, so it is stored here and used
+		// as parent context of controllers started at runtime. It also triggers the initial check of capabilities.
+		WatchesRawSource(source.Func(func(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+			r.ctx = ctx
+			q.Add(capabilityRequest)
+			return nil
+		})).
+		Complete(r)
+}
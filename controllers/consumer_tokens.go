@@ -0,0 +1,253 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+	svcres "github.com/IBM/ibm-licensing-operator/controllers/resources/service"
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/api/v1alpha1"
+)
+
+// Returns the active IBMLicensing instance if it has scoped API tokens enabled, nil otherwise
+func (r *bindingCopier) getScopedTokensInstance(ctx context.Context) (*operatorv1alpha1.IBMLicensing, error) {
+	instanceList := operatorv1alpha1.IBMLicensingList{}
+	if err := r.Client.List(ctx, &instanceList); err != nil {
+		return nil, err
+	}
+	for i := range instanceList.Items {
+		instance := &instanceList.Items[i]
+		if instance.Status.State == svcres.ActiveCRState && instance.Spec.ScopedAPITokens {
+			return instance, nil
+		}
+	}
+	return nil, nil
+}
+
+/*
+Issues API token for namespace of the owner and stores it in the copy of API token secret, instead of the token shared
+by the whole cluster. Requeues until the consumer tokens secret is created by IBMLicensing controller.
+*/
+func (r *bindingCopier) copyConsumerToken(ctx context.Context, reqLogger logr.Logger, instance *operatorv1alpha1.IBMLicensing,
+	bindingCopy svcres.BindingCopy, owner client.Object) (requeue bool, err error) {
+	namespace := owner.GetNamespace()
+	if namespace == instance.Spec.InstanceNamespace && bindingCopy.Source == bindingCopy.Target {
+		return false, nil
+	}
+
+	tokensSecret := corev1.Secret{}
+	tokensSecretName := types.NamespacedName{Namespace: instance.Spec.InstanceNamespace, Name: svcres.ConsumerTokensSecretName}
+	if err := r.Reader.Get(ctx, tokensSecretName, &tokensSecret); err != nil {
+		if apierrors.IsNotFound(err) {
+			reqLogger.Info("Consumer tokens secret not found", "name", tokensSecretName.Name, "namespace", tokensSecretName.Namespace)
+			return true, nil
+		}
+		return false, err
+	}
+
+	token, issued, err := svcres.IssueConsumerToken(&tokensSecret, namespace, time.Now())
+	if err != nil {
+		return false, err
+	}
+	if issued {
+		reqLogger.Info("Issuing API token for namespace", "namespace", namespace)
+		if err := r.Client.Update(ctx, &tokensSecret); err != nil {
+			reqLogger.Error(err, "failed to update consumer tokens secret", "name", tokensSecretName.Name)
+			return false, err
+		}
+		r.updateIssuedAPITokens(ctx, reqLogger, instance, &tokensSecret)
+	}
+
+	tokenData := map[string][]byte{svcres.APISecretTokenKeyName: []byte(token)}
+	tokenCopy := corev1.Secret{}
+	// Use Reader (bypasses label-filtered cache), same as for copies of other secrets
+	if err := r.Reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: bindingCopy.Target}, &tokenCopy); err != nil {
+		if !apierrors.IsNotFound(err) {
+			reqLogger.Error(err, "failed to get Secret", "name", bindingCopy.Target, "namespace", namespace)
+			return false, err
+		}
+		tokenCopy = corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: bindingCopy.Target, Namespace: namespace}}
+	}
+	revision := svcres.GetSourceRevision(tokenData, nil)
+	if tokenCopy.ResourceVersion != "" && tokenCopy.Annotations[svcres.SourceRevisionAnnotation] == revision &&
+		metav1.IsControlledBy(&tokenCopy, owner) {
+		return false, nil
+	}
+
+	if tokenCopy.Labels == nil {
+		tokenCopy.Labels = map[string]string{}
+	}
+	tokenCopy.Labels[res.LicensingReleaseLabelKey] = svcres.LicensingReleaseName
+	tokenCopy.Labels[svcres.BindingKeyLabel] = bindingCopy.Key
+	if tokenCopy.Annotations == nil {
+		tokenCopy.Annotations = map[string]string{}
+	}
+	tokenCopy.Annotations[svcres.SourceRevisionAnnotation] = revision
+	tokenCopy.Type = corev1.SecretTypeOpaque
+	tokenCopy.Data = tokenData
+	tokenCopy.StringData = nil
+	tokenCopy.OwnerReferences = nil
+	if err := controllerutil.SetControllerReference(owner, &tokenCopy, r.Scheme); err != nil {
+		reqLogger.Error(err, "failed to set owner of Secret", "OwnerName", owner.GetName(), "SecretName", tokenCopy.Name, "namespace", namespace)
+		return false, err
+	}
+
+	if tokenCopy.ResourceVersion == "" {
+		err = r.Client.Create(ctx, &tokenCopy)
+	} else {
+		err = r.Client.Update(ctx, &tokenCopy)
+	}
+	if err != nil {
+		reqLogger.Error(err, "failed to create or update Secret with API token", "name", bindingCopy.Target, "namespace", namespace)
+	}
+	return false, err
+}
+
+/*
+Revokes API tokens of namespaces, in which no OperandRequest or IBMLicensingAccessRequest requests the API token anymore,
+f.e. because the request or the whole namespace was deleted.
+*/
+func (r *bindingCopier) pruneConsumerTokens(ctx context.Context, reqLogger logr.Logger) error {
+	instance, err := r.getScopedTokensInstance(ctx)
+	if err != nil || instance == nil {
+		return err
+	}
+	bindings, err := r.getBindings(ctx)
+	if err != nil {
+		return err
+	}
+
+	requests, err := r.listRequestedBindings(ctx)
+	if err != nil {
+		return err
+	}
+	tokenSecretName := instance.Spec.GetAPISecretTokenName()
+	keep := map[string]bool{}
+	for _, request := range requests {
+		for _, bindingCopy := range svcres.GetBindingCopies(bindings, request.Bindings) {
+			if bindingCopy.Kind == svcres.SecretKind && bindingCopy.Source == tokenSecretName {
+				keep[request.Namespace] = true
+			}
+		}
+	}
+
+	tokensSecret := corev1.Secret{}
+	tokensSecretName := types.NamespacedName{Namespace: instance.Spec.InstanceNamespace, Name: svcres.ConsumerTokensSecretName}
+	if err := r.Reader.Get(ctx, tokensSecretName, &tokensSecret); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	revoked := svcres.RevokeConsumerTokens(&tokensSecret, keep)
+	if len(revoked) == 0 {
+		return nil
+	}
+	reqLogger.Info("Revoking API tokens of namespaces without requests", "namespaces", revoked)
+	if err := r.Client.Update(ctx, &tokensSecret); err != nil {
+		return err
+	}
+	r.updateIssuedAPITokens(ctx, reqLogger, instance, &tokensSecret)
+	return nil
+}
+
+// Lists tokens from consumer tokens secret in IBMLicensing status
+func (r *bindingCopier) updateIssuedAPITokens(ctx context.Context, reqLogger logr.Logger, instance *operatorv1alpha1.IBMLicensing,
+	tokensSecret *corev1.Secret) {
+	original := instance.DeepCopy()
+	instance.Status.IssuedAPITokens = svcres.GetIssuedAPITokens(tokensSecret)
+	if err := r.Client.Status().Patch(ctx, instance, client.MergeFrom(original)); err != nil {
+		reqLogger.Info("Failed to list issued API tokens in IBMLicensing status, this does not affect License Service", "error", err.Error())
+	}
+}
+
+// Bindings requested by an OperandRequest or IBMLicensingAccessRequest, which are copied to its namespace
+type requestedBindings struct {
+	Namespace string
+	Bindings  map[string]odlm.SecretConfigmap
+}
+
+// Returns bindings requested by OperandRequests for ibm-licensing-operator and IBMLicensingAccessRequests, which are not being deleted
+func (r *bindingCopier) listRequestedBindings(ctx context.Context) ([]requestedBindings, error) {
+	var requests []requestedBindings
+	operandRequests := odlm.OperandRequestList{}
+	if err := r.Client.List(ctx, &operandRequests); err != nil && !meta.IsNoMatchError(err) {
+		return nil, err
+	}
+	for _, operandRequest := range operandRequests.Items {
+		if operandRequest.DeletionTimestamp.IsZero() && res.HasOperandRequestBindingForLicensing(operandRequest) {
+			requests = append(requests, requestedBindings{Namespace: operandRequest.Namespace, Bindings: getOperandRequestBindings(operandRequest)})
+		}
+	}
+	accessRequests := operatorv1alpha1.IBMLicensingAccessRequestList{}
+	if err := r.Client.List(ctx, &accessRequests); err != nil {
+		return nil, err
+	}
+	for _, accessRequest := range accessRequests.Items {
+		if accessRequest.DeletionTimestamp.IsZero() {
+			requests = append(requests, requestedBindings{Namespace: accessRequest.Namespace, Bindings: getAccessRequestBindings(accessRequest)})
+		}
+	}
+	return requests, nil
+}
+
+/*
+Returns names of copies of the Secret from operator namespace, which are expected in namespaces of requests sharing it.
+Copies of API token secret are skipped when scoped API tokens are enabled, as they hold tokens issued for their namespaces.
+*/
+func (r *bindingCopier) getSecretCopies(ctx context.Context, sourceName string) ([]types.NamespacedName, error) {
+	scopedTokensInstance, err := r.getScopedTokensInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if scopedTokensInstance != nil && sourceName == scopedTokensInstance.Spec.GetAPISecretTokenName() {
+		return nil, nil
+	}
+	bindings, err := r.getBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requests, err := r.listRequestedBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var copies []types.NamespacedName
+	for _, request := range requests {
+		for _, bindingCopy := range svcres.GetBindingCopies(bindings, request.Bindings) {
+			if bindingCopy.Kind != svcres.SecretKind || bindingCopy.Source != sourceName {
+				continue
+			}
+			target := types.NamespacedName{Namespace: request.Namespace, Name: bindingCopy.Target}
+			// Secret shared under its own name with operator namespace is not copied
+			if target != (types.NamespacedName{Namespace: r.OperatorNamespace, Name: sourceName}) && !slices.Contains(copies, target) {
+				copies = append(copies, target)
+			}
+		}
+	}
+	return copies, nil
+}
@@ -0,0 +1,83 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+)
+
+const (
+	// Interval of reporting progress by idle background loops
+	heartbeatInterval = 30 * time.Second
+	// Background task, which has not reported progress for this time, is considered stuck and fails the liveness check
+	heartbeatTimeout = 10 * time.Minute
+
+	operatorGroupCoordinatorHeartbeat = "operatorgroup-coordinator"
+	staleNamespacesTaskHeartbeat      = "operatorgroup-namespaces-watcher"
+	operandRequestDiscoveryHeartbeat  = "operandrequest-discovery"
+)
+
+// +kubebuilder:rbac:namespace=ibm-licensing,groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
+
+/*
+AddHealthChecks registers liveness and readiness checks of the operator. The operator is live unless any background task
+is stuck, and ready once the manager cache is synced, cluster capabilities are detected and, with leader election enabled,
+the replica is the leader or a standby of the leader holding the lease in operator namespace.
+*/
+func AddHealthChecks(mgr ctrl.Manager, operatorNamespace string, capabilities *res.ClusterCapabilities, heartbeats *res.Heartbeats) error {
+	if err := mgr.AddHealthzCheck("heartbeats", heartbeats.Check); err != nil {
+		return err
+	}
+	if err := mgr.AddReadyzCheck("cache-sync", func(req *http.Request) error {
+		// Request context is cancelled on the probe timeout, so that the check does not block until the cache is synced
+		if !mgr.GetCache().WaitForCacheSync(req.Context()) {
+			return errors.New("cache is not synced")
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := mgr.AddReadyzCheck("capabilities", capabilities.ReadyCheck); err != nil {
+		return err
+	}
+	return mgr.AddReadyzCheck("leader-election", func(req *http.Request) error {
+		// Closed immediately if leader election is disabled
+		select {
+		case <-mgr.Elected():
+			return nil
+		default:
+		}
+		// Standby replicas are ready as long as there is a leader, so that they do not block rollouts
+		lease := &coordinationv1.Lease{}
+		if err := mgr.GetAPIReader().Get(req.Context(), types.NamespacedName{Namespace: operatorNamespace, Name: res.LeaderElectionID}, lease); err != nil {
+			return fmt.Errorf("cannot read leader election lease: %w", err)
+		}
+		if !res.IsLeaseHeld(lease, time.Now()) {
+			return errors.New("no operator replica holds the leader election lease")
+		}
+		return nil
+	})
+}
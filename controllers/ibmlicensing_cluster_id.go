@@ -0,0 +1,98 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources/service"
+)
+
+// +kubebuilder:rbac:groups=config.openshift.io,resources=clusterversions,verbs=get
+
+/*
+Injects stable cluster ID into spec.sender of the reconciled instance, when it is not set by the user, so that
+License Service Reporter does not see a new cluster after every reinstallation. The ID is generated once and
+persisted in a ConfigMap, which is not removed together with the instance.
+*/
+func (r *IBMLicensingReconciler) reconcileClusterID(instance *operatorv1alpha1.IBMLicensing) (reconcile.Result, error) {
+	if instance.Spec.Sender == nil || instance.Spec.Sender.ClusterID != "" {
+		return reconcile.Result{}, nil
+	}
+	reqLogger := r.Log.WithValues("reconcileClusterID", "Entry", "instance.GetName()", instance.GetName())
+
+	configMap := corev1.ConfigMap{}
+	configMapName := types.NamespacedName{Namespace: instance.Spec.InstanceNamespace, Name: service.ClusterIDConfigMapName}
+	err := r.Reader.Get(context.TODO(), configMapName, &configMap)
+	if err == nil && configMap.Data[service.ClusterIDConfigMapKey] != "" {
+		instance.Spec.Sender.ClusterID = configMap.Data[service.ClusterIDConfigMapKey]
+		return reconcile.Result{}, nil
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		reqLogger.Error(err, "Failed to get cluster ID ConfigMap")
+		return reconcile.Result{}, err
+	}
+
+	clusterID, source, err := r.generateClusterID(context.TODO())
+	if err != nil {
+		reqLogger.Error(err, "Failed to generate cluster ID")
+		return reconcile.Result{}, err
+	}
+	expectedConfigMap := service.GetClusterIDConfigMap(instance, clusterID, source)
+	if configMap.ResourceVersion == "" {
+		err = r.Client.Create(context.TODO(), expectedConfigMap)
+	} else {
+		configMap.Data = expectedConfigMap.Data
+		err = r.Client.Update(context.TODO(), &configMap)
+	}
+	if err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return reconcile.Result{Requeue: true, RequeueAfter: time.Second * 2}, nil
+		}
+		reqLogger.Error(err, "Failed to persist cluster ID")
+		return reconcile.Result{}, err
+	}
+
+	reqLogger.Info("Generated cluster ID for multi-cluster reporting", "clusterID", clusterID, "source", source)
+	instance.Spec.Sender.ClusterID = clusterID
+	return reconcile.Result{}, nil
+}
+
+/*
+Returns ID of OpenShift cluster from ClusterVersion, or UID of kube-system namespace on other clusters.
+Both stay the same for the whole lifetime of the cluster.
+*/
+func (r *IBMLicensingReconciler) generateClusterID(ctx context.Context) (string, string, error) {
+	clusterVersion := configv1.ClusterVersion{}
+	if err := r.Reader.Get(ctx, types.NamespacedName{Name: "version"}, &clusterVersion); err == nil && clusterVersion.Spec.ClusterID != "" {
+		return string(clusterVersion.Spec.ClusterID), service.ClusterVersionClusterIDSource, nil
+	}
+
+	kubeSystem := corev1.Namespace{}
+	if err := r.Reader.Get(ctx, types.NamespacedName{Name: "kube-system"}, &kubeSystem); err != nil {
+		return "", "", err
+	}
+	return string(kubeSystem.UID), service.KubeSystemClusterIDSource, nil
+}
@@ -17,15 +17,19 @@
 package controllers
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"os"
 	"reflect"
 	goruntime "runtime"
-	"sort"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
+	configv1 "github.com/openshift/api/config/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -33,15 +37,21 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	apieq "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	metaErrors "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -55,37 +65,118 @@ import (
 type reconcileLSFunctionType = func(*operatorv1alpha1.IBMLicensing) (reconcile.Result, error)
 
 func (r *IBMLicensingReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	if err := res.UpdateCacheClusterExtensions(mgr.GetAPIReader(), r.Log); err != nil {
-		r.Log.Error(err, "Error during checking K8s API")
-	}
-
-	if cap(r.NamespaceScopeSemaphore) != 1 {
-		panic("NamespaceScopeSemaphore must have capacity 1!")
-	}
+	r.mgr = mgr
+	r.ownedWatches = map[string]bool{}
+	r.capabilityEvents = make(chan event.GenericEvent)
 
 	watcher := ctrl.NewControllerManagedBy(mgr).
 		For(&operatorv1alpha1.IBMLicensing{}).
 		Owns(&appsv1.Deployment{}).
-		Owns(&corev1.Service{})
+		Owns(&corev1.Service{}).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.instancesWithNamespaceSelector),
+			builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		WatchesRawSource(source.Channel(r.capabilityEvents, &handler.EnqueueRequestForObject{}))
 
-	if res.IsGatewayAPI {
-		watcher = watcher.
-			Owns(&gatewayv1.Gateway{}).
-			Owns(&gatewayv1.HTTPRoute{})
+	licensingController, err := watcher.Build(r)
+	if err != nil {
+		return err
 	}
+	r.controller = licensingController
 
-	if res.IsBackendTLSPolicyAPI {
-		watcher = watcher.
-			Owns(&gatewayv1.BackendTLSPolicy{})
+	return r.UpdateOwnedWatches(context.Background())
+}
+
+/*
+UpdateOwnedWatches starts watching resources of optional APIs owned or used by IBMLicensing, once they become available in the cluster.
+When an API is removed, the informer of its resource is stopped, so that it can be watched again after reinstallation.
+*/
+func (r *IBMLicensingReconciler) UpdateOwnedWatches(ctx context.Context) error {
+	r.ownedWatchesMutex.Lock()
+	defer r.ownedWatchesMutex.Unlock()
+
+	ownerHandler := handler.EnqueueRequestForOwner(r.mgr.GetScheme(), r.mgr.GetRESTMapper(), &operatorv1alpha1.IBMLicensing{}, handler.OnlyControllerOwner())
+	ownedObjects := []struct {
+		object  client.Object
+		enabled bool
+		handler handler.EventHandler
+	}{
+		{&gatewayv1.Gateway{}, r.Capabilities.IsGatewayAPI(), ownerHandler},
+		{&gatewayv1.HTTPRoute{}, r.Capabilities.IsGatewayAPI(), ownerHandler},
+		{&gatewayv1.BackendTLSPolicy{}, r.Capabilities.IsBackendTLSPolicyAPI(), ownerHandler},
+		// Settings of the cluster-wide Proxy are passed to License Service
+		{&configv1.Proxy{}, r.Capabilities.IsClusterProxyAPI(), handler.EnqueueRequestsFromMapFunc(r.instancesUsingClusterProxy)},
+	}
+
+	for _, owned := range ownedObjects {
+		kind := reflect.TypeOf(owned.object).Elem().Name()
+		switch {
+		case owned.enabled && !r.ownedWatches[kind]:
+			if err := r.controller.Watch(source.Kind(r.mgr.GetCache(), owned.object, owned.handler)); err != nil {
+				return err
+			}
+			r.Log.Info("Watching resources of optional API", "kind", kind)
+			r.ownedWatches[kind] = true
+		case !owned.enabled && r.ownedWatches[kind]:
+			if err := r.mgr.GetCache().RemoveInformer(ctx, owned.object); err != nil {
+				return err
+			}
+			r.Log.Info("Stopped watching resources, as their API is no longer available", "kind", kind)
+			r.ownedWatches[kind] = false
+		}
 	}
+	return nil
+}
 
-	return watcher.Complete(r)
+// RequeueAllInstances triggers reconciliation of all IBMLicensing instances, e.g. after cluster capabilities changed
+func (r *IBMLicensingReconciler) RequeueAllInstances(ctx context.Context) error {
+	instanceList := &operatorv1alpha1.IBMLicensingList{}
+	if err := r.Client.List(ctx, instanceList); err != nil {
+		return err
+	}
+	for i := range instanceList.Items {
+		select {
+		case r.capabilityEvents <- event.GenericEvent{Object: &instanceList.Items[i]}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Reads the default instance template either from the ConfigMap in operator namespace or from the file, if configured
+func (r *IBMLicensingReconciler) getDefaultInstanceTemplate() ([]byte, error) {
+	defaultInstanceConfig := r.Config.Get().DefaultInstance
+	if defaultInstanceConfig.TemplateConfigMap != "" {
+		templateConfigMap := &corev1.ConfigMap{}
+		namespacedName := types.NamespacedName{Namespace: r.OperatorNamespace, Name: defaultInstanceConfig.TemplateConfigMap}
+		if err := r.Reader.Get(context.TODO(), namespacedName, templateConfigMap); err != nil {
+			return nil, err
+		}
+		template, ok := templateConfigMap.Data[service.DefaultInstanceTemplateKey]
+		if !ok {
+			return nil, fmt.Errorf("%s key not found in ConfigMap %s", service.DefaultInstanceTemplateKey, defaultInstanceConfig.TemplateConfigMap)
+		}
+		return []byte(template), nil
+	}
+	if defaultInstanceConfig.TemplateFile != "" {
+		return os.ReadFile(defaultInstanceConfig.TemplateFile)
+	}
+	return nil, nil
 }
 
 func (r *IBMLicensingReconciler) createDefaultInstanceAfterCheck() error {
 	reqLogger := r.Log.WithValues("action", "Default IBMLicensing instance creation")
-	ibmLicensing := service.GetDefaultIBMLicensing(r.OperatorNamespace)
-	err := r.Client.Create(context.TODO(), &ibmLicensing)
+	template, err := r.getDefaultInstanceTemplate()
+	if err != nil {
+		reqLogger.Error(err, "Cannot read default IBMLicensing instance template.")
+		return err
+	}
+	ibmLicensing, err := service.GetDefaultIBMLicensingFromTemplate(r.OperatorNamespace, template)
+	if err != nil {
+		reqLogger.Error(err, "Failure.")
+		return err
+	}
+	err = r.Client.Create(context.TODO(), &ibmLicensing)
 	if err != nil && !apierrors.IsAlreadyExists(err) {
 		reqLogger.Error(err, "Failure.")
 		return err
@@ -96,6 +187,10 @@ func (r *IBMLicensingReconciler) createDefaultInstanceAfterCheck() error {
 
 func (r *IBMLicensingReconciler) CreateDefaultInstance(checkIfInstancesExist bool) error {
 	reqLogger := r.Log.WithValues("action", "Default IBMLicensing instance existence check")
+	if !*r.Config.Get().DefaultInstance.Create {
+		reqLogger.Info("Creation of the default IBMLicensing instance is disabled.")
+		return nil
+	}
 	// need to check if any instance already exists
 	if checkIfInstancesExist {
 		// Fetch all IBMLicensing instances
@@ -123,11 +218,29 @@ type IBMLicensingReconciler struct {
 	// that reads objects from the cache and writes to the apiserver
 	client.Client
 	client.Reader
-	Log                     logr.Logger
-	Scheme                  *runtime.Scheme
-	Recorder                record.EventRecorder
-	OperatorNamespace       string
-	NamespaceScopeSemaphore chan bool
+	Log               logr.Logger
+	Scheme            *runtime.Scheme
+	Recorder          record.EventRecorder
+	OperatorNamespace string
+	Capabilities      *res.ClusterCapabilities
+	// Operator configuration, defaults are used if not set
+	Config *res.OperatorConfigStore
+
+	mgr        ctrl.Manager
+	controller controller.Controller
+	// Generic events used for requeueing instances when cluster capabilities change
+	capabilityEvents chan event.GenericEvent
+	// Kinds of owned resources from optional APIs, which are currently watched
+	ownedWatches      map[string]bool
+	ownedWatchesMutex sync.Mutex
+	// Results of the last reporter connectivity checks by instance UID
+	senderChecks map[types.UID]senderCheck
+	// Instances, which connectivity check is running in the background
+	senderChecksInProgress map[types.UID]bool
+	senderChecksMutex      sync.Mutex
+	// Namespaces resolved from the namespace scope selector by instance UID
+	namespaceScopes      map[types.UID]namespaceScope
+	namespaceScopesMutex sync.Mutex
 }
 
 // //kubebuilder:rbac:namespace=ibm-licensing,groups=,resources=pod,verbs=get;list;watch;create;update;patch;delete
@@ -160,10 +273,6 @@ func (r *IBMLicensingReconciler) Reconcile(_ context.Context, req reconcile.Requ
 	reqLogger.Info("Reconciling IBMLicensing")
 	goruntime.GC()
 
-	if err := res.UpdateCacheClusterExtensions(r.Reader, reqLogger); err != nil {
-		reqLogger.Error(err, "Error during checking K8s API")
-	}
-
 	// Fetch all IBMLicensing instances
 	ibmLicensingList := &operatorv1alpha1.IBMLicensingList{}
 	if err := r.Client.List(context.TODO(), ibmLicensingList); err != nil {
@@ -190,19 +299,17 @@ func (r *IBMLicensingReconciler) Reconcile(_ context.Context, req reconcile.Requ
 
 	if foundInstance == nil {
 		reqLogger.Info("Did not find request name in instances, probably it was deleted.")
-		if !hasIBMLicensingListActiveInstance(ibmLicensingList) {
-			return reconcile.Result{}, r.findAndMarkActiveIBMLicensing(ibmLicensingList)
-		}
-		return reconcile.Result{}, nil
+		_, err := r.findAndMarkActiveIBMLicensing(ibmLicensingList)
+		return reconcile.Result{}, err
 	}
 
-	// Check if there are any active CR or if they are properly marked (field .State)
-	if !hasIBMLicensingListActiveInstance(ibmLicensingList) || foundInstance.Status.State == "" {
-		err := r.findAndMarkActiveIBMLicensing(ibmLicensingList)
-		if err != nil {
-			reqLogger.Error(err, "Failed to update IBMLicensing CR status.")
-			return reconcile.Result{}, err
-		}
+	// Check if the right CR is active and all CRs are properly marked (field .State)
+	markingChanged, err := r.findAndMarkActiveIBMLicensing(ibmLicensingList)
+	if err != nil {
+		reqLogger.Error(err, "Failed to update IBMLicensing CR status.")
+		return reconcile.Result{}, err
+	}
+	if markingChanged {
 		return reconcile.Result{Requeue: true}, nil
 	}
 
@@ -212,30 +319,42 @@ func (r *IBMLicensingReconciler) Reconcile(_ context.Context, req reconcile.Requ
 		return reconcile.Result{}, nil
 	}
 
+	// Ignore reconciliation if it is paused, f.e. during manual maintenance of License Service resources
+	if service.IsReconciliationPaused(foundInstance) {
+		reqLogger.Info("Ignoring reconciliation because it is paused with " + service.PauseReconciliationAnnotation + " annotation")
+		return reconcile.Result{}, nil
+	}
+
 	instance := foundInstance.DeepCopy()
 
-	err := service.UpdateVersion(r.Client, instance)
+	err = service.UpdateVersion(r.Client, instance)
 	if err != nil {
 		reqLogger.Error(err, "Can not update version in CR")
 	}
 
-	err = instance.Spec.FillDefaultValues(reqLogger, res.IsServiceCAAPI, res.IsRouteAPI, res.RHMPEnabled,
-		res.IsAlertingEnabledByDefault, r.OperatorNamespace)
+	capabilities := r.Capabilities.Get()
+	err = instance.Spec.FillDefaultValues(reqLogger, capabilities.ServiceCAAPI, capabilities.RouteAPI, capabilities.RHMP,
+		capabilities.IsAlertingEnabledByDefault(), r.OperatorNamespace)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
 	if instance.Spec.GatewayOptions == nil {
 		instance.Spec.GatewayOptions = &operatorv1alpha1.IBMLicensingGatewayOptions{}
-		isOCPCluster := res.IsRouteAPI || res.IsServiceCAAPI
+		isOCPCluster := r.Capabilities.IsOCPCluster()
 		instance.Spec.GatewayOptions.EnableGatewayAPIOpenshift = isOCPCluster
 	} else {
-		isOCPCluster := res.IsRouteAPI || res.IsServiceCAAPI
+		isOCPCluster := r.Capabilities.IsOCPCluster()
 		if !isOCPCluster && instance.Spec.GatewayOptions.EnableGatewayAPIOpenshift {
 			reqLogger.Info("Warning: enableGatewayAPIOpenshift is set to true on non-OpenShift cluster. This flag is ignored on Kubernetes clusters where Gateway API logging is always enabled.")
 		}
 	}
 
+	// Proxy configured with envVariable before outboundNetwork was introduced keeps taking precedence over the cluster Proxy
+	if migrated := service.MigrateEnvVariableOutboundNetwork(&instance.Spec); len(migrated) > 0 {
+		reqLogger.Info("Proxy from envVariable is used as outboundNetwork, consider moving it to spec.outboundNetwork", "variables", migrated)
+	}
+
 	// Validate Software Central configuration
 	if instance.Spec.IsSoftwareCentralEnabled() && instance.Spec.SoftwareCentral.EntitlementKeySecret == "" {
 		return reconcile.Result{}, fmt.Errorf("spec.softwareCentral.entitlementKeySecret must be set when Software Central integration is enabled")
@@ -255,6 +374,7 @@ func (r *IBMLicensingReconciler) Reconcile(_ context.Context, req reconcile.Requ
 	reconcileFunctions := []interface{}{
 		r.reconcileAPISecretToken,
 		r.reconcileUploadToken,
+		r.reconcileConsumerTokens,
 		r.reconcileDefaultReaderToken,
 		r.reconcileServiceAccountToken,
 		r.reconcileServices,
@@ -262,92 +382,201 @@ func (r *IBMLicensingReconciler) Reconcile(_ context.Context, req reconcile.Requ
 		r.reconcileCertificateSecrets,
 		r.reconcileRouteWithCertificates,
 		r.reconcileConfigMaps,
+		r.reconcileClusterID,
+		r.reconcileNamespaceScope,
+		r.reconcileOutboundNetwork,
 		r.reconcileDeployment,
 		r.reconcileNetworkPolicy,
 		r.reconcileExposure,
 		r.reconcileRHMPServiceMonitor,
 		r.reconcileAlertingServiceMonitor,
 		r.reconcileMeterDefinition,
+		r.reconcileSenderStatus,
+		r.reconcileSoftwareCentralStatus,
 	}
 
+	// The earliest requested RequeueAfter is kept, f.e. for scheduled rotation of tokens
+	var requeueAfter time.Duration
 	for _, reconcileFunction := range reconcileFunctions {
 		recResult, err = reconcileFunction.(reconcileLSFunctionType)(instance)
 		if err != nil || recResult.Requeue {
 			return recResult, err
 		}
+		if recResult.RequeueAfter > 0 && (requeueAfter == 0 || recResult.RequeueAfter < requeueAfter) {
+			requeueAfter = recResult.RequeueAfter
+		}
 	}
 
-	// Using 1-size channel
-	// Tries sending data to the channel. If it fails, attempts to clear the channel
-	select {
-	case r.NamespaceScopeSemaphore <- foundInstance.Spec.IsNamespaceScopeEnabled():
-	default:
-		// This select prevents race condition, should the channel be cleared in the meantime
-		select {
-		case <-r.NamespaceScopeSemaphore:
-		default:
+	// Variables from spec.envVariable are checked against the instance with default values, as they are in the deployment
+	operandConfigurationIssues := service.GetOperandConfigurationIssues(instance.Spec, capabilities)
+	if len(operandConfigurationIssues) > 0 {
+		reqLogger.Info("Some variables from envVariable are invalid or override other spec fields", "issues", operandConfigurationIssues)
+		condition := service.GetOperandConfigurationCondition(operandConfigurationIssues)
+		if previous := meta.FindStatusCondition(foundInstance.Status.Conditions, condition.Type); previous == nil || previous.Message != condition.Message {
+			r.Recorder.Event(foundInstance, corev1.EventTypeWarning, condition.Reason, condition.Message)
 		}
-		// Sends current data. At this point channel will contain only the newest data, without race conditions
-		r.NamespaceScopeSemaphore <- foundInstance.Spec.IsNamespaceScopeEnabled()
 	}
 
 	// Update status logic, using foundInstance, because we do not want to add filled default values to yaml
-	return r.updateStatus(foundInstance, reqLogger)
+	recResult, err = r.updateStatus(foundInstance, operandConfigurationIssues, reqLogger)
+	if err == nil && !recResult.Requeue && requeueAfter > 0 {
+		recResult.RequeueAfter = requeueAfter
+	}
+	return recResult, err
 }
 
-func (r *IBMLicensingReconciler) findAndMarkActiveIBMLicensing(ibmlicensingList *operatorv1alpha1.IBMLicensingList) error {
+/*
+findAndMarkActiveIBMLicensing selects the active IBMLicensing instance and marks all instances accordingly (field .State
+and the Active condition). Returns true if the status of any instance was changed.
+
+When the active instance changes (f.e. priority or the selection annotation was modified), the previously active instance
+is marked inactive and its operands are handed over (tokens are kept, workloads are deleted) before the newly selected
+one is marked active, so that both instances never reconcile the same resources at the same time.
+*/
+func (r *IBMLicensingReconciler) findAndMarkActiveIBMLicensing(ibmlicensingList *operatorv1alpha1.IBMLicensingList) (bool, error) {
 	if len(ibmlicensingList.Items) == 0 {
-		return nil
+		return false, nil
 	}
 
-	// Sort by creation timestamp
-	sort.SliceStable(ibmlicensingList.Items, func(i, j int) bool {
-		return ibmlicensingList.Items[i].ObjectMeta.CreationTimestamp.Time.Before(ibmlicensingList.Items[j].ObjectMeta.CreationTimestamp.Time)
-	})
+	selected, reason := service.SelectActiveIBMLicensing(ibmlicensingList.Items)
+	activeInstance := selected.DeepCopy()
+	changed := false
 
-	// First element is oldest one and should only be active
-	initialInstance := ibmlicensingList.Items[0]
-
-	var cr operatorv1alpha1.IBMLicensing
-	// Mark all CRs states depending on their creation time
-	for _, cr = range ibmlicensingList.Items {
-		// Only firstly created instance is marked as 'active' and will be reconciled
-		if cr.UID == initialInstance.UID {
-			r.Log.Info("Due to having first creation timestamp the active IBMLicensing instance CR is named: " + cr.Name)
-			cr.Status.State = service.ActiveCRState
-		} else {
-			// CR should be marked as 'inactive' and ignored during next reconciliation
+	for i := range ibmlicensingList.Items {
+		cr := &ibmlicensingList.Items[i]
+		if cr.UID == activeInstance.UID {
+			continue
+		}
+		wasActive := cr.Status.State == service.ActiveCRState
+		updated, err := r.markIBMLicensingState(cr, service.InactiveCRState, activeInstance, reason)
+		if err != nil {
+			return changed, err
+		}
+		if updated {
+			changed = true
+			// CR is marked as 'inactive' and will be ignored during next reconciliation
 			r.Log.Error(nil, fmt.Sprintf(
 				`There's more than one IBMLicensing Custom Resource created.
 				IBM License Service configuration is stored in the %s Custom Resource, other Custom Resources are ignored.
 				You can safely go to the Custom Resource Definitions view, select IBMLicensings, backup the YAML definitions of ignored Custom Resources,
 				and delete them from the cluster to prevent this error to appear again.
 				These ignored Custom Resources have no effect on the IBM License Service operation.
-				%s will be ignored and set as inactive.`, initialInstance.Name, cr.Name))
-			if cr.Status.State != service.InactiveCRState {
-				cr.Status.State = service.InactiveCRState
+				%s will be ignored and set as inactive.`, activeInstance.Name, cr.Name))
+		}
+		if wasActive {
+			r.Log.Info("Active IBMLicensing instance changed, handing over operands of the previously active one",
+				"previous", cr.Name, "active", activeInstance.Name, "reason", reason)
+			if err := r.handOverOperands(cr, activeInstance); err != nil {
+				return changed, err
+			}
+		}
+	}
+
+	updated, err := r.markIBMLicensingState(activeInstance, service.ActiveCRState, activeInstance, reason)
+	if err != nil {
+		return changed, err
+	}
+	if updated {
+		r.Log.Info("The active IBMLicensing instance CR is named: "+activeInstance.Name, "reason", reason)
+		changed = true
+	}
+
+	return changed, nil
+}
+
+// Sets the state and the Active condition of the instance, status is updated only if it has changed
+func (r *IBMLicensingReconciler) markIBMLicensingState(instance *operatorv1alpha1.IBMLicensing, state string,
+	activeInstance *operatorv1alpha1.IBMLicensing, reason string) (bool, error) {
+	status := instance.Status.DeepCopy()
+	status.State = state
+	meta.SetStatusCondition(&status.Conditions, service.GetActiveCondition(instance, activeInstance, reason))
+	if apieq.Semantic.DeepEqual(*status, instance.Status) {
+		return false, nil
+	}
+	instance.Status = *status
+	return true, r.Client.Status().Update(context.TODO(), instance)
+}
+
+/*
+Hands over resources controlled by the previously active IBMLicensing instance to the new active one. Secrets and
+ConfigMaps, like API and upload tokens and their copies configuration, are kept and controlled by the new instance, so
+that tokens already copied to consumers stay valid. Workload resources are deleted, so that the new instance creates
+them from its own configuration. Resources controlled by other operator's resources (f.e. service monitors owned by the
+prometheus service) are removed by the garbage collector together with their owners.
+*/
+func (r *IBMLicensingReconciler) handOverOperands(instance, activeInstance *operatorv1alpha1.IBMLicensing) error {
+	namespace := instance.Spec.InstanceNamespace
+	if namespace == "" {
+		namespace = r.OperatorNamespace
+	}
+	activeNamespace := activeInstance.Spec.InstanceNamespace
+	if activeNamespace == "" {
+		activeNamespace = r.OperatorNamespace
+	}
+	reqLogger := r.Log.WithValues("ibmlicensing", instance.Name, "namespace", namespace)
+
+	// Configuration is not used by the new instance if it runs in another namespace
+	keepConfiguration := namespace == activeNamespace
+	operandLists := []struct {
+		list          client.ObjectList
+		configuration bool
+	}{
+		{&corev1.SecretList{}, true},
+		{&corev1.ConfigMapList{}, true},
+		{&appsv1.DeploymentList{}, false},
+		{&corev1.ServiceList{}, false},
+		{&networkingv1.NetworkPolicyList{}, false},
+		{&routev1.RouteList{}, false},
+		{&gatewayv1.GatewayList{}, false},
+		{&gatewayv1.HTTPRouteList{}, false},
+		{&gatewayv1.BackendTLSPolicyList{}, false},
+	}
+
+	for _, operands := range operandLists {
+		if err := r.Reader.List(context.TODO(), operands.list, client.InNamespace(namespace)); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
 			}
+			return err
 		}
-		err := r.Client.Status().Update(context.TODO(), &cr)
+		items, err := meta.ExtractList(operands.list)
 		if err != nil {
 			return err
 		}
+		for _, item := range items {
+			operand, ok := item.(client.Object)
+			if !ok || !metav1.IsControlledBy(operand, instance) {
+				continue
+			}
+			if operands.configuration && keepConfiguration {
+				if err := r.transferControllerReference(operand, instance, activeInstance); err != nil {
+					return err
+				}
+				reqLogger.Info("Operand handed over to the active instance", "Name", operand.GetName(), "active", activeInstance.Name)
+				continue
+			}
+			if _, err := res.DeleteResource(&reqLogger, r.Client, operand); err != nil {
+				return err
+			}
+		}
 	}
-
 	return nil
 }
 
-func hasIBMLicensingListActiveInstance(ibmlicensingList *operatorv1alpha1.IBMLicensingList) bool {
-	// Iterate over the ibmlicensingList items and check if there is any active CR
-	for _, s := range ibmlicensingList.Items {
-		if s.Status.State == service.ActiveCRState {
-			return true
-		}
+// Replaces controller reference of the previous owner with the new one, keeping the data of the resource
+func (r *IBMLicensingReconciler) transferControllerReference(operand client.Object, previous, owner *operatorv1alpha1.IBMLicensing) error {
+	ownerReferences := slices.DeleteFunc(slices.Clone(operand.GetOwnerReferences()), func(ownerReference metav1.OwnerReference) bool {
+		return ownerReference.UID == previous.UID
+	})
+	operand.SetOwnerReferences(ownerReferences)
+	if err := controllerutil.SetControllerReference(owner, operand, r.Scheme); err != nil {
+		return err
 	}
-	return false
+	return r.Client.Update(context.TODO(), operand)
 }
 
-func (r *IBMLicensingReconciler) updateStatus(instance *operatorv1alpha1.IBMLicensing, reqLogger logr.Logger) (reconcile.Result, error) {
+func (r *IBMLicensingReconciler) updateStatus(instance *operatorv1alpha1.IBMLicensing, operandConfigurationIssues []string,
+	reqLogger logr.Logger) (reconcile.Result, error) {
 	podList := &corev1.PodList{}
 	listOpts := []client.ListOption{
 		client.InNamespace(instance.Spec.InstanceNamespace),
@@ -385,17 +614,65 @@ func (r *IBMLicensingReconciler) updateStatus(instance *operatorv1alpha1.IBMLice
 
 	var rhmpEnabled bool
 	if instance.Spec.RHMPEnabled == nil {
-		rhmpEnabled = res.RHMPEnabled
+		rhmpEnabled = r.Capabilities.IsRHMP()
 	} else {
 		rhmpEnabled = *instance.Spec.RHMPEnabled
 	}
 
 	featuresStatuses.RHMPEnabled = &rhmpEnabled
 
-	if !apieq.Semantic.DeepEqual(podStatuses, instance.Status.LicensingPods) || !apieq.Semantic.DeepEqual(featuresStatuses, instance.Status.Features) {
+	// instance is not filled with default values, so the default instance namespace is resolved here
+	instanceNamespace := instance.Spec.InstanceNamespace
+	if instanceNamespace == "" {
+		instanceNamespace = r.OperatorNamespace
+	}
+
+	var issuedAPITokens []operatorv1alpha1.IssuedAPIToken
+	if instance.Spec.ScopedAPITokens {
+		consumerTokens := &corev1.Secret{}
+		consumerTokensName := types.NamespacedName{Namespace: instanceNamespace, Name: service.ConsumerTokensSecretName}
+		if err := r.Reader.Get(context.TODO(), consumerTokensName, consumerTokens); err == nil {
+			issuedAPITokens = service.GetIssuedAPITokens(consumerTokens)
+		} else if !apierrors.IsNotFound(err) {
+			reqLogger.Error(err, "Failed to get consumer tokens secret")
+			return reconcile.Result{}, err
+		}
+	}
+
+	var clusterID string
+	if instance.Spec.Sender != nil {
+		clusterIDConfigMap := &corev1.ConfigMap{}
+		clusterIDConfigMapName := types.NamespacedName{Namespace: instanceNamespace, Name: service.ClusterIDConfigMapName}
+		if err := r.Reader.Get(context.TODO(), clusterIDConfigMapName, clusterIDConfigMap); err != nil {
+			clusterIDConfigMap = nil
+		}
+		clusterID = service.GetEffectiveClusterID(instance.Spec, clusterIDConfigMap)
+	}
+
+	conditions := slices.Clone(instance.Status.Conditions)
+	senderStatus := r.getSenderStatus(instance, &conditions)
+	namespaceScopeStatus := r.getNamespaceScopeStatus(instance, &conditions)
+	softwareCentralStatus := r.getSoftwareCentralStatus(context.TODO(), instance, instanceNamespace, &conditions)
+	if len(instance.Spec.EnvVariable) > 0 {
+		meta.SetStatusCondition(&conditions, service.GetOperandConfigurationCondition(operandConfigurationIssues))
+	} else {
+		meta.RemoveStatusCondition(&conditions, service.OperandConfigurationValidConditionType)
+	}
+
+	if !apieq.Semantic.DeepEqual(podStatuses, instance.Status.LicensingPods) || !apieq.Semantic.DeepEqual(featuresStatuses, instance.Status.Features) ||
+		!apieq.Semantic.DeepEqual(issuedAPITokens, instance.Status.IssuedAPITokens) ||
+		!apieq.Semantic.DeepEqual(senderStatus, instance.Status.Sender) || !apieq.Semantic.DeepEqual(conditions, instance.Status.Conditions) ||
+		!apieq.Semantic.DeepEqual(softwareCentralStatus, instance.Status.SoftwareCentral) ||
+		!apieq.Semantic.DeepEqual(namespaceScopeStatus, instance.Status.NamespaceScope) || clusterID != instance.Status.ClusterID {
 		reqLogger.Info("Updating IBMLicensing status")
 		instance.Status.LicensingPods = podStatuses
 		instance.Status.Features = featuresStatuses
+		instance.Status.IssuedAPITokens = issuedAPITokens
+		instance.Status.Sender = senderStatus
+		instance.Status.Conditions = conditions
+		instance.Status.ClusterID = clusterID
+		instance.Status.SoftwareCentral = softwareCentralStatus
+		instance.Status.NamespaceScope = namespaceScopeStatus
 		err := r.Client.Status().Update(context.TODO(), instance)
 		if err != nil {
 			reqLogger.Info("Failed to update pod status, this does not affect License Service")
@@ -530,7 +807,11 @@ func (r *IBMLicensingReconciler) reconcileAPISecretToken(instance *operatorv1alp
 		return result, err
 	}
 
-	return r.attachSpecLabelsAndAnnotations(instance, foundSecret, &reqLogger)
+	result, err = r.attachSpecLabelsAndAnnotations(instance, foundSecret, &reqLogger)
+	if err != nil || result.Requeue {
+		return result, err
+	}
+	return r.reconcileTokenRotation(instance, foundSecret, service.APISecretTokenKeyName, reqLogger)
 }
 
 // default reader token is not created by default since kubernetes 1.24, we need to ensure it is always generated
@@ -615,6 +896,124 @@ func (r *IBMLicensingReconciler) reconcileUploadToken(instance *operatorv1alpha1
 		return result, err
 	}
 
+	result, err = r.attachSpecLabelsAndAnnotations(instance, foundSecret, &reqLogger)
+	if err != nil || result.Requeue {
+		return result, err
+	}
+	return r.reconcileTokenRotation(instance, foundSecret, service.APIUploadTokenKeyName, reqLogger)
+}
+
+/*
+Rotates token stored in the secret under the key, when rotation interval elapsed or rotation annotation changed.
+The previous token stays valid during the overlap period and is retired only after all copies of the secret
+shared with consumers contain the new token.
+*/
+func (r *IBMLicensingReconciler) reconcileTokenRotation(instance *operatorv1alpha1.IBMLicensing, secret *corev1.Secret, key string,
+	reqLogger logr.Logger) (reconcile.Result, error) {
+	now := time.Now()
+
+	if service.IsTokenRotationDue(instance, secret, now) {
+		reqLogger.Info("Rotating token", "secret", secret.Name)
+		if err := service.RotateToken(instance, secret, key, now); err != nil {
+			return reconcile.Result{}, err
+		}
+		if err := r.Client.Update(context.TODO(), secret); err != nil {
+			reqLogger.Error(err, "Failed to update secret with rotated token", "secret", secret.Name)
+			return reconcile.Result{}, err
+		}
+		r.Recorder.Event(instance, corev1.EventTypeNormal, "TokenRotated", fmt.Sprintf("Token in secret %s rotated, previous token is valid for %s",
+			secret.Name, instance.Spec.GetTokenOverlapPeriod()))
+
+		// Current token is mounted with subPath, which is not updated in running pods
+		deploymentNsName := types.NamespacedName{Name: service.GetResourceName(instance), Namespace: instance.Spec.InstanceNamespace}
+		if err := r.rolloutRestartDeployment(deploymentNsName); err != nil && !apierrors.IsNotFound(err) {
+			reqLogger.Info("Failed to roll update deployment")
+			return reconcile.Result{}, err
+		}
+	} else if service.IsPreviousTokenExpired(secret, key, now) {
+		staleCopies, err := r.getStaleTokenCopies(secret, key)
+		if err != nil {
+			reqLogger.Info("Cannot verify copies of token secret, postponing retirement of previous token", "secret", secret.Name, "error", err.Error())
+			return reconcile.Result{RequeueAfter: time.Minute}, nil
+		}
+		if len(staleCopies) > 0 {
+			reqLogger.Info("Postponing retirement of previous token until copies are updated", "secret", secret.Name, "copies", staleCopies)
+			return reconcile.Result{RequeueAfter: time.Minute}, nil
+		}
+
+		reqLogger.Info("Retiring previous token", "secret", secret.Name)
+		service.RetirePreviousToken(secret, key)
+		if err := r.Client.Update(context.TODO(), secret); err != nil {
+			reqLogger.Error(err, "Failed to retire previous token", "secret", secret.Name)
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: service.GetNextTokenRotationCheck(instance, secret, key, now)}, nil
+}
+
+/*
+Returns namespaced names of copies of token secret shared with consumers, which do not hold the current token yet.
+Copies are looked up in namespaces of OperandRequests and IBMLicensingAccessRequests sharing the secret.
+*/
+func (r *IBMLicensingReconciler) getStaleTokenCopies(secret *corev1.Secret, key string) ([]string, error) {
+	if secret.Namespace != r.OperatorNamespace {
+		return nil, nil
+	}
+	copier := &bindingCopier{
+		Client:            r.Client,
+		Reader:            r.Reader,
+		Scheme:            r.Scheme,
+		OperatorNamespace: r.OperatorNamespace,
+		BindingsConfigMap: r.Config.Get().BindingsConfigMap,
+	}
+	copies, err := copier.getSecretCopies(context.TODO(), secret.Name)
+	if err != nil {
+		return nil, err
+	}
+	var stale []string
+	for _, copyName := range copies {
+		tokenCopy := corev1.Secret{}
+		if err := r.Reader.Get(context.TODO(), copyName, &tokenCopy); err != nil {
+			if apierrors.IsNotFound(err) {
+				// Copy created later gets the current token
+				continue
+			}
+			return nil, err
+		}
+		if !bytes.Equal(tokenCopy.Data[key], secret.Data[key]) {
+			stale = append(stale, copyName.String())
+		}
+	}
+	return stale, nil
+}
+
+/*
+Secret with API tokens issued for consumer namespaces exists only when scoped API tokens are enabled.
+Its creation and deletion triggers copying of API token to consumer namespaces again, with scoped or shared token.
+*/
+func (r *IBMLicensingReconciler) reconcileConsumerTokens(instance *operatorv1alpha1.IBMLicensing) (reconcile.Result, error) {
+	reqLogger := r.Log.WithValues("reconcileConsumerTokens", "Entry", "instance.GetName()", instance.GetName())
+	expectedSecret := service.GetConsumerTokensSecret(instance)
+	if !instance.Spec.ScopedAPITokens {
+		foundSecret := &corev1.Secret{}
+		err := r.Reader.Get(context.TODO(), types.NamespacedName{Name: expectedSecret.Name, Namespace: expectedSecret.Namespace}, foundSecret)
+		if err != nil {
+			return reconcile.Result{}, client.IgnoreNotFound(err)
+		}
+		if !metav1.IsControlledBy(foundSecret, instance) {
+			return reconcile.Result{}, nil
+		}
+		reqLogger.Info("Scoped API tokens disabled, deleting consumer tokens secret")
+		return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(context.TODO(), foundSecret))
+	}
+
+	foundSecret := &corev1.Secret{}
+	result, err := r.reconcileResourceNamespacedExistence(instance, expectedSecret, foundSecret)
+	if err != nil || result.Requeue {
+		return result, err
+	}
+
 	return r.attachSpecLabelsAndAnnotations(instance, foundSecret, &reqLogger)
 }
 
@@ -674,7 +1073,7 @@ func (r *IBMLicensingReconciler) reconcileServices(instance *operatorv1alpha1.IB
 		err    error
 	)
 	reqLogger := r.Log.WithValues("reconcileServices", "Entry", "instance.GetName()", instance.GetName())
-	expected, notExpected := service.GetServices(instance)
+	expected, notExpected := service.GetServices(instance, r.Capabilities.Get())
 	found := &corev1.Service{}
 	for _, es := range expected {
 		result, err = r.reconcileResourceNamespacedExistence(instance, es, found)
@@ -728,7 +1127,7 @@ func (r *IBMLicensingReconciler) reconcileServiceMonitor(instance *operatorv1alp
 		return reconcile.Result{}, nil
 	}
 
-	owner := service.GetPrometheusService(instance)
+	owner := service.GetPrometheusService(instance, r.Capabilities.Get())
 	result, err := res.UpdateOwner(&reqLogger, r.Client, owner)
 	if err != nil || result.Requeue {
 		return result, err
@@ -747,7 +1146,7 @@ func (r *IBMLicensingReconciler) reconcileNetworkPolicy(instance *operatorv1alph
 	if instance.Spec.IsPrometheusServiceNeeded() {
 		reqLogger := r.Log.WithValues("reconcileNetworkPolicy", "Entry", "instance.GetName()", instance.GetName())
 		expected := service.GetNetworkPolicy(instance)
-		owner := service.GetPrometheusService(instance)
+		owner := service.GetPrometheusService(instance, r.Capabilities.Get())
 		result, err := res.UpdateOwner(&reqLogger, r.Client, owner)
 		if err != nil || result.Requeue {
 			return result, err
@@ -768,7 +1167,7 @@ func (r *IBMLicensingReconciler) reconcileNetworkPolicy(instance *operatorv1alph
 
 func (r *IBMLicensingReconciler) reconcileDeployment(instance *operatorv1alpha1.IBMLicensing) (reconcile.Result, error) {
 	reqLogger := r.Log.WithValues("reconcileDeployment", "Entry", "instance.GetName()", instance.GetName())
-	expectedDeployment := service.GetLicensingDeployment(instance)
+	expectedDeployment := service.GetLicensingDeployment(instance, r.Capabilities.Get())
 
 	foundDeployment := &appsv1.Deployment{}
 	reconcileResult, err := r.reconcileResourceNamespacedExistence(instance, expectedDeployment, foundDeployment)
@@ -804,7 +1203,7 @@ func (r *IBMLicensingReconciler) reconcileCertificateSecrets(instance *operatorv
 	var hostname []string
 	var rolloutPods bool
 
-	if res.IsRouteAPI && instance.Spec.IsRouteEnabled() {
+	if r.Capabilities.IsRouteAPI() && instance.Spec.IsRouteEnabled() {
 		// for backward compatibility, we treat the "ocp" HTTPSCertsSource same as "self-signed"
 		if instance.Spec.HTTPSCertsSource == "custom" {
 			r.Log.Info("Skipping external certificate reconciliation - custom certificate set")
@@ -825,14 +1224,14 @@ func (r *IBMLicensingReconciler) reconcileCertificateSecrets(instance *operatorv
 		rolloutPods = false
 	} else {
 		// skip certificate creation only for OCP environment if route is disabled
-		if res.IsServiceCAAPI {
+		if r.Capabilities.IsServiceCAAPI() {
 			r.Log.Info("Skipping certificate creation for OCP - route is disabled via configuration")
 			return reconcile.Result{}, nil
 		}
 	}
 
 	// Reconcile internal certificate only on non-OCP environments
-	if !res.IsServiceCAAPI {
+	if !r.Capabilities.IsServiceCAAPI() {
 		r.Log.Info("Reconciling internal certificate")
 
 		namespacedName = types.NamespacedName{Namespace: instance.Spec.InstanceNamespace, Name: service.LicenseServiceInternalCertName}
@@ -847,7 +1246,7 @@ func (r *IBMLicensingReconciler) reconcileCertificateSecrets(instance *operatorv
 }
 
 func (r *IBMLicensingReconciler) reconcileRouteWithCertificates(instance *operatorv1alpha1.IBMLicensing) (reconcile.Result, error) {
-	if res.IsRouteAPI && instance.Spec.IsRouteEnabled() {
+	if r.Capabilities.IsRouteAPI() && instance.Spec.IsRouteEnabled() {
 		r.Log.Info("Reconciling route with certificate")
 		externalCertSecret := corev1.Secret{}
 		var externalCertName string
@@ -907,7 +1306,7 @@ func (r *IBMLicensingReconciler) reconcileRouteWithoutCertificates(instance *ope
 	route := &routev1.Route{}
 	expectedRoute := service.GetLicensingRoute(instance, defaultRouteTLS)
 
-	if res.IsRouteAPI && instance.Spec.IsRouteEnabled() {
+	if r.Capabilities.IsRouteAPI() && instance.Spec.IsRouteEnabled() {
 		routeNamespacedName := types.NamespacedName{Namespace: instance.Spec.InstanceNamespace, Name: service.GetResourceName(instance)}
 		if err := r.Client.Get(context.TODO(), routeNamespacedName, route); err != nil {
 			r.Log.Info("Route does not exist, reconciling route without certificates")
@@ -929,7 +1328,7 @@ func (r *IBMLicensingReconciler) reconcileRouteWithoutCertificates(instance *ope
 }
 
 func (r *IBMLicensingReconciler) reconcileRouteWithTLS(instance *operatorv1alpha1.IBMLicensing, defaultRouteTLS *routev1.TLSConfig) (reconcile.Result, error) {
-	if res.IsRouteAPI && instance.Spec.IsRouteEnabled() {
+	if r.Capabilities.IsRouteAPI() && instance.Spec.IsRouteEnabled() {
 		expectedRoute := service.GetLicensingRoute(instance, defaultRouteTLS)
 		foundRoute := &routev1.Route{}
 		reconcileResult, err := r.reconcileResourceNamespacedExistence(instance, expectedRoute, foundRoute)
@@ -1015,7 +1414,7 @@ func (r *IBMLicensingReconciler) reconcileGateway(instance *operatorv1alpha1.IBM
 	if err != nil || result.Requeue {
 		return result, err
 	}
-	if found.GetName() != "" && !res.IsRouteAPI && !res.IsServiceCAAPI {
+	if found.GetName() != "" && !r.Capabilities.IsOCPCluster() {
 		if len(found.Status.Addresses) > 0 {
 			for _, addr := range found.Status.Addresses {
 				if addr.Type != nil && *addr.Type == gatewayv1.HostnameAddressType {
@@ -1078,8 +1477,8 @@ func isGatewayResource(resType reflect.Type) bool {
 		resType == reflect.TypeOf(&corev1.ConfigMap{})
 }
 
-func shouldLogGatewayResourceStatus(instance *operatorv1alpha1.IBMLicensing, resType reflect.Type) bool {
-	isOCPCluster := res.IsRouteAPI || res.IsServiceCAAPI
+func (r *IBMLicensingReconciler) shouldLogGatewayResourceStatus(instance *operatorv1alpha1.IBMLicensing, resType reflect.Type) bool {
+	isOCPCluster := r.Capabilities.IsOCPCluster()
 	isGatewayRes := isGatewayResource(resType)
 
 	if !isGatewayRes {
@@ -1129,7 +1528,7 @@ func (r *IBMLicensingReconciler) reconcileExpectedGatewayResource(instance *oper
 	}
 	// handling not installed CRD
 	if found.GetName() == "" {
-		isNonOCPCluster := !res.IsRouteAPI && !res.IsServiceCAAPI
+		isNonOCPCluster := !r.Capabilities.IsOCPCluster()
 		if isNonOCPCluster {
 			reqLogger.Info("Resource not found (CRD likely not installed), skipping update check see documentation about needed cluster extensions ibm.biz/LS_gateway_API")
 		}
@@ -1185,7 +1584,7 @@ func (r *IBMLicensingReconciler) reconcileMeterDefinition(instance *operatorv1al
 	reqLogger := r.Log.WithValues("reconcileMeterDefinition", "Entry", "instance.GetName()", instance.GetName())
 	expectedMeterDefinitionList := service.GetMeterDefinitionList(instance)
 	found := &rhmp.MeterDefinition{}
-	owner := service.GetPrometheusService(instance)
+	owner := service.GetPrometheusService(instance, r.Capabilities.Get())
 	result, err := res.UpdateOwner(&r.Log, r.Client, owner)
 	if err != nil || result.Requeue {
 		return result, err
@@ -1340,8 +1739,8 @@ func (r *IBMLicensingReconciler) reconcileResourceExistence(
 			reqLogger.Info(resType.String()+" created successfully, waiting for token generation", "Name", expectedRes.GetName(),
 				"Namespace", expectedRes.GetNamespace())
 			return reconcile.Result{Requeue: true, RequeueAfter: time.Second * 5}, nil
-		} else if metaErrors.IsNoMatchError(err) {
-			if shouldLogGatewayResourceStatus(instance, resType) {
+		} else if meta.IsNoMatchError(err) {
+			if r.shouldLogGatewayResourceStatus(instance, resType) {
 				reqLogger.Info("CRD for "+resType.String()+" not installed, skipping", "Name", expectedRes.GetName(),
 					"Namespace", expectedRes.GetNamespace())
 			}
@@ -1352,7 +1751,7 @@ func (r *IBMLicensingReconciler) reconcileResourceExistence(
 		return reconcile.Result{}, err
 	}
 
-	if shouldLogGatewayResourceStatus(instance, resType) {
+	if r.shouldLogGatewayResourceStatus(instance, resType) {
 		reqLogger.Info(resType.String() + " exists!")
 	}
 	return reconcile.Result{}, nil
@@ -1378,7 +1777,7 @@ func (r *IBMLicensingReconciler) reconcileResourceWhichShouldNotExist(
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			return reconcile.Result{}, nil
-		} else if metaErrors.IsNoMatchError(err) {
+		} else if meta.IsNoMatchError(err) {
 			return reconcile.Result{}, nil
 		}
 		reqLogger.Error(err, "Failed to get "+resType.String(), "Name", expectedRes.GetName(),
@@ -1411,12 +1810,12 @@ func (r *IBMLicensingReconciler) controllerStatus(instance *operatorv1alpha1.IBM
 	} else {
 		r.handleLicenseNotAccepted(instance)
 	}
-	if res.IsRouteAPI {
+	if r.Capabilities.IsRouteAPI() {
 		r.Log.Info("Route feature is enabled")
 	} else {
 		r.Log.Info("Route feature is disabled")
 	}
-	if res.IsServiceCAAPI {
+	if r.Capabilities.IsServiceCAAPI() {
 		r.Log.Info("ServiceCA feature is enabled")
 	} else {
 		r.Log.Info("ServiceCA feature is disabled")
@@ -1481,8 +1880,9 @@ func (r *IBMLicensingReconciler) reconcileSelfSignedCertificate(instance *operat
 		regenerateCertificate = true
 	}
 	// if certificate is expired
-	if cert.NotAfter.Before(time.Now().AddDate(0, 0, 90)) {
-		r.Log.Info("Self signed certificate is expiring in less than 90 days.")
+	renewalWindow := r.Config.Get().CertificateRenewalWindow.Duration
+	if cert.NotAfter.Before(time.Now().Add(renewalWindow)) {
+		r.Log.Info("Self signed certificate is expiring in less than " + renewalWindow.String() + ".")
 		regenerateCertificate = true
 	}
 	// if certificate is not issued to the proper host
@@ -1544,18 +1944,8 @@ func (r *IBMLicensingReconciler) reconcileSelfSignedCertificate(instance *operat
 }
 
 func (r *IBMLicensingReconciler) rolloutRestartDeployment(deploymentNsName types.NamespacedName) error {
-	r.Log.Info("Performing rolling restart of deployment")
-	data := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":"%s"}}}}}`, time.Now().String())
-	patch := []byte(data)
-
-	r.Log.Info(data)
-
-	return r.Client.Patch(context.TODO(), &appsv1.Deployment{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: deploymentNsName.Namespace,
-			Name:      deploymentNsName.Name,
-		},
-	}, client.RawPatch(types.MergePatchType, patch))
+	r.Log.Info("Performing rolling restart of deployment", "deployment", deploymentNsName)
+	return res.RolloutRestartDeployment(context.TODO(), r.Client, deploymentNsName)
 }
 
 func (r *IBMLicensingReconciler) handleLicenseNotAccepted(instance *operatorv1alpha1.IBMLicensing) {
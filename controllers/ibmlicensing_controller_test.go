@@ -20,14 +20,22 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	routev1 "github.com/openshift/api/route/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	rhmp "github.com/IBM/ibm-licensing-operator/pkg/rhmp/v1beta1"
@@ -944,6 +952,52 @@ var _ = Describe("IBMLicensing controller", Ordered, func() {
 	})
 })
 
+var _ = Describe("IBMLicensing handover", func() {
+	It("Should keep tokens and delete workloads of the previously active instance", func(ctx SpecContext) {
+		testScheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(testScheme)).To(Succeed())
+		Expect(operatorv1alpha1.AddToScheme(testScheme)).To(Succeed())
+		Expect(routev1.AddToScheme(testScheme)).To(Succeed())
+		Expect(gatewayv1.Install(testScheme)).To(Succeed())
+
+		previous := &operatorv1alpha1.IBMLicensing{
+			ObjectMeta: metav1.ObjectMeta{Name: "previous", UID: "previous-uid"},
+			Spec:       operatorv1alpha1.IBMLicensingSpec{InstanceNamespace: "ibm-licensing"},
+		}
+		active := &operatorv1alpha1.IBMLicensing{
+			ObjectMeta: metav1.ObjectMeta{Name: "active", UID: "active-uid"},
+			Spec:       operatorv1alpha1.IBMLicensingSpec{InstanceNamespace: "ibm-licensing"},
+		}
+		token := &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: service.LicensingToken, Namespace: "ibm-licensing"},
+			Data:       map[string][]byte{service.APISecretTokenKeyName: []byte("token-value")},
+		}
+		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: service.GetResourceName(previous), Namespace: "ibm-licensing"}}
+		for _, operand := range []client.Object{token, deployment} {
+			Expect(controllerutil.SetControllerReference(previous, operand, testScheme)).To(Succeed())
+		}
+
+		fakeClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(previous, active, token, deployment).Build()
+		reconciler := &IBMLicensingReconciler{
+			Client:            fakeClient,
+			Reader:            fakeClient,
+			Log:               logr.Discard(),
+			Scheme:            testScheme,
+			OperatorNamespace: "ibm-licensing",
+		}
+		Expect(reconciler.handOverOperands(previous, active)).To(Succeed())
+
+		handedOver := &v1.Secret{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(token), handedOver)).To(Succeed())
+		Expect(handedOver.Data[service.APISecretTokenKeyName]).To(Equal([]byte("token-value")))
+		Expect(metav1.IsControlledBy(handedOver, active)).To(BeTrue())
+		Expect(handedOver.OwnerReferences).To(HaveLen(1))
+
+		err := fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), &appsv1.Deployment{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
+
 func checkBasicRequirements(ctx context.Context, instance, newInstance *operatorv1alpha1.IBMLicensing) {
 	Expect(k8sClient.Create(ctx, instance)).Should(Succeed())
 
@@ -0,0 +1,189 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources/service"
+)
+
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+/*
+How long access of License Service to selected namespaces is trusted, before it is checked again to detect RBAC changes.
+Access is checked right away when the selected namespaces or the service account change.
+*/
+const namespaceAccessCheckInterval = 10 * time.Minute
+
+// Namespace scope resolved for an instance, with the time when access to its namespaces was checked
+type namespaceScope struct {
+	status         operatorv1alpha1.IBMLicensingNamespaceScopeStatus
+	serviceAccount string
+	checkedAt      time.Time
+}
+
+/*
+Resolves spec.features.nssNamespaceSelector against Namespaces and publishes the result to License Service in the
+generated ConfigMap. License Service reads its scope on startup, so it is restarted when the scope changes.
+Namespaces, in which the restricted service account of License Service cannot list pods, are reported as denied.
+*/
+func (r *IBMLicensingReconciler) reconcileNamespaceScope(instance *operatorv1alpha1.IBMLicensing) (reconcile.Result, error) {
+	if !instance.Spec.IsNamespaceScopeSelector() {
+		r.namespaceScopesMutex.Lock()
+		delete(r.namespaceScopes, instance.UID)
+		r.namespaceScopesMutex.Unlock()
+		return reconcile.Result{}, nil
+	}
+	reqLogger := r.Log.WithValues("reconcileNamespaceScope", "Entry", "instance.GetName()", instance.GetName())
+
+	selector, err := metav1.LabelSelectorAsSelector(instance.Spec.Features.NamespaceScopeSelector)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("spec.features.nssNamespaceSelector is invalid: %w", err)
+	}
+	namespaceList := &corev1.NamespaceList{}
+	if err := r.Client.List(context.TODO(), namespaceList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		reqLogger.Error(err, "Failed to list namespaces")
+		return reconcile.Result{}, err
+	}
+	var namespaces []string
+	for _, namespace := range namespaceList.Items {
+		if namespace.Status.Phase != corev1.NamespaceTerminating {
+			namespaces = append(namespaces, namespace.Name)
+		}
+	}
+	slices.Sort(namespaces)
+
+	expectedCM := service.GetNamespaceScopeConfigMap(instance, namespaces)
+	foundCM := &corev1.ConfigMap{}
+	reconcileResult, err := r.reconcileResourceNamespacedExistence(instance, expectedCM, foundCM)
+	if err != nil || reconcileResult.Requeue {
+		return reconcileResult, err
+	}
+	if !res.CompareConfigMapData(foundCM, expectedCM) {
+		reqLogger.Info("Namespace scope changed", "namespaces", namespaces)
+		r.attachSpecLabelsAndAnnotationsPrecedingUpdate(instance, expectedCM)
+		if updateReconcileResult, err := res.UpdateResource(&reqLogger, r.Client, expectedCM, foundCM); err != nil || updateReconcileResult.Requeue {
+			return updateReconcileResult, err
+		}
+		deploymentNsName := types.NamespacedName{Name: service.GetResourceName(instance), Namespace: instance.Spec.InstanceNamespace}
+		if err := r.rolloutRestartDeployment(deploymentNsName); err != nil && !apierrors.IsNotFound(err) {
+			reqLogger.Error(err, "Failed to restart License Service after namespace scope change")
+			return reconcile.Result{}, err
+		}
+	}
+
+	serviceAccount := "system:serviceaccount:" + instance.Spec.InstanceNamespace + ":" + service.GetServiceAccountName(instance)
+	r.namespaceScopesMutex.Lock()
+	previous, found := r.namespaceScopes[instance.UID]
+	r.namespaceScopesMutex.Unlock()
+	if found && previous.serviceAccount == serviceAccount && slices.Equal(previous.status.Namespaces, namespaces) {
+		if sinceCheck := time.Since(previous.checkedAt); sinceCheck < namespaceAccessCheckInterval {
+			return reconcile.Result{RequeueAfter: namespaceAccessCheckInterval - sinceCheck}, nil
+		}
+	}
+
+	deniedNamespaces, err := r.getDeniedNamespaces(context.TODO(), serviceAccount, namespaces)
+	if err != nil {
+		reqLogger.Error(err, "Failed to check access of License Service to selected namespaces")
+		return reconcile.Result{}, err
+	}
+	r.namespaceScopesMutex.Lock()
+	defer r.namespaceScopesMutex.Unlock()
+	if r.namespaceScopes == nil {
+		r.namespaceScopes = map[types.UID]namespaceScope{}
+	}
+	r.namespaceScopes[instance.UID] = namespaceScope{
+		status: operatorv1alpha1.IBMLicensingNamespaceScopeStatus{
+			Namespaces:       namespaces,
+			DeniedNamespaces: deniedNamespaces,
+		},
+		serviceAccount: serviceAccount,
+		checkedAt:      time.Now(),
+	}
+	return reconcile.Result{RequeueAfter: namespaceAccessCheckInterval}, nil
+}
+
+// Returns namespaces, in which the service account of License Service is not permitted to list pods
+func (r *IBMLicensingReconciler) getDeniedNamespaces(ctx context.Context, serviceAccount string, namespaces []string) ([]string, error) {
+	var denied []string
+	for _, namespace := range namespaces {
+		review := &authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				User: serviceAccount,
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: namespace,
+					Verb:      "list",
+					Resource:  "pods",
+				},
+			},
+		}
+		if err := r.Client.Create(ctx, review); err != nil {
+			return nil, err
+		}
+		if !review.Status.Allowed {
+			denied = append(denied, namespace)
+		}
+	}
+	return denied, nil
+}
+
+// Returns namespace scope status of the instance and sets or removes its NamespaceScopeReady condition
+func (r *IBMLicensingReconciler) getNamespaceScopeStatus(instance *operatorv1alpha1.IBMLicensing, conditions *[]metav1.Condition) *operatorv1alpha1.IBMLicensingNamespaceScopeStatus {
+	r.namespaceScopesMutex.Lock()
+	scope, found := r.namespaceScopes[instance.UID]
+	r.namespaceScopesMutex.Unlock()
+
+	if !instance.Spec.IsNamespaceScopeSelector() || !found {
+		meta.RemoveStatusCondition(conditions, service.NamespaceScopeReadyConditionType)
+		return nil
+	}
+	condition := service.GetNamespaceScopeCondition(scope.status, instance.Spec.Features.NamespaceScopeDenialLimit)
+	condition.ObservedGeneration = instance.Generation
+	meta.SetStatusCondition(conditions, condition)
+	return &scope.status
+}
+
+// Returns requests for instances selecting namespaces by labels, to resolve their scope again after namespace change
+func (r *IBMLicensingReconciler) instancesWithNamespaceSelector(ctx context.Context, _ client.Object) []reconcile.Request {
+	instanceList := &operatorv1alpha1.IBMLicensingList{}
+	if err := r.Client.List(ctx, instanceList); err != nil {
+		r.Log.Error(err, "Failed to list IBMLicensing instances")
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, instance := range instanceList.Items {
+		if instance.Spec.IsNamespaceScopeSelector() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: instance.Name}})
+		}
+	}
+	return requests
+}
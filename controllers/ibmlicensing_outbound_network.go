@@ -0,0 +1,81 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources/service"
+)
+
+// +kubebuilder:rbac:groups=config.openshift.io,resources=proxies,verbs=get;list;watch
+
+/*
+Injects settings of the OpenShift cluster-wide Proxy into spec.outboundNetwork of the reconciled instance, for fields
+not set by the user. When the Proxy defines a trusted CA, a ConfigMap is created for OpenShift to inject the cluster
+trusted CA bundle into, as the bundle in openshift-config namespace cannot be mounted directly.
+*/
+func (r *IBMLicensingReconciler) reconcileOutboundNetwork(instance *operatorv1alpha1.IBMLicensing) (reconcile.Result, error) {
+	if !r.Capabilities.IsOCPCluster() || !instance.Spec.IsClusterProxyUsed() {
+		return reconcile.Result{}, nil
+	}
+	reqLogger := r.Log.WithValues("reconcileOutboundNetwork", "Entry", "instance.GetName()", instance.GetName())
+
+	proxy := configv1.Proxy{}
+	if err := r.Reader.Get(context.TODO(), types.NamespacedName{Name: service.ClusterProxyName}, &proxy); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return reconcile.Result{}, nil
+		}
+		reqLogger.Error(err, "Failed to get cluster-wide Proxy")
+		return reconcile.Result{}, err
+	}
+	instance.Spec.OutboundNetwork = service.MergeClusterProxy(instance.Spec.OutboundNetwork, &proxy)
+
+	if instance.Spec.OutboundNetwork != nil && instance.Spec.OutboundNetwork.TrustedCABundle == service.TrustedCABundleConfigMapName {
+		// data of the ConfigMap is managed by OpenShift, so only its existence is reconciled
+		return r.reconcileResourceNamespacedExistence(instance, service.GetTrustedCABundleConfigMap(instance), &corev1.ConfigMap{})
+	}
+	return reconcile.Result{}, nil
+}
+
+// Maps change of the OpenShift cluster-wide Proxy to IBMLicensing instances using its settings
+func (r *IBMLicensingReconciler) instancesUsingClusterProxy(ctx context.Context, proxy client.Object) []reconcile.Request {
+	if proxy.GetName() != service.ClusterProxyName {
+		return nil
+	}
+	instanceList := &operatorv1alpha1.IBMLicensingList{}
+	if err := r.Client.List(ctx, instanceList); err != nil {
+		r.Log.Error(err, "Failed to list IBMLicensing instances")
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, instance := range instanceList.Items {
+		if instance.Spec.IsClusterProxyUsed() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: instance.Name}})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,182 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources/service"
+)
+
+// How often connection to License Service Reporter is checked, unless the instance changes
+const senderCheckInterval = 5 * time.Minute
+
+// Requeue interval of an instance, which connectivity check is in progress, so that its result is reported in status
+const senderCheckInProgressRequeue = service.ReporterCheckTimeout + 5*time.Second
+
+type senderCheck struct {
+	generation  int64
+	checkedAt   time.Time
+	condition   metav1.Condition
+	diagnostics []string
+}
+
+/*
+Validates secrets referenced by spec.sender and checks connection to License Service Reporter. The check runs in
+the background, so that a slow or unreachable reporter does not delay the reconciliation. Its result is kept in memory
+and reported in status by updateStatus on the next reconciliation. Failed check never blocks the reconciliation of License Service.
+*/
+func (r *IBMLicensingReconciler) reconcileSenderStatus(instance *operatorv1alpha1.IBMLicensing) (reconcile.Result, error) {
+	r.senderChecksMutex.Lock()
+	defer r.senderChecksMutex.Unlock()
+	if r.senderChecks == nil {
+		r.senderChecks = map[types.UID]senderCheck{}
+		r.senderChecksInProgress = map[types.UID]bool{}
+	}
+
+	if instance.Spec.Sender == nil {
+		delete(r.senderChecks, instance.UID)
+		return reconcile.Result{}, nil
+	}
+	if r.senderChecksInProgress[instance.UID] {
+		return reconcile.Result{RequeueAfter: senderCheckInProgressRequeue}, nil
+	}
+	if previous, found := r.senderChecks[instance.UID]; found && previous.generation == instance.Generation {
+		if sinceCheck := time.Since(previous.checkedAt); sinceCheck < senderCheckInterval {
+			return reconcile.Result{RequeueAfter: senderCheckInterval - sinceCheck}, nil
+		}
+	}
+
+	r.senderChecksInProgress[instance.UID] = true
+	checkedInstance := instance.DeepCopy()
+	go func() {
+		check := r.checkSender(context.Background(), checkedInstance)
+		if check.condition.Status != metav1.ConditionTrue {
+			r.Log.Info("License Service Reporter connectivity check failed", "reason", check.condition.Reason, "diagnostics", check.diagnostics)
+		}
+		r.senderChecksMutex.Lock()
+		defer r.senderChecksMutex.Unlock()
+		r.senderChecks[checkedInstance.UID] = check
+		delete(r.senderChecksInProgress, checkedInstance.UID)
+	}()
+	return reconcile.Result{RequeueAfter: senderCheckInProgressRequeue}, nil
+}
+
+func (r *IBMLicensingReconciler) checkSender(ctx context.Context, instance *operatorv1alpha1.IBMLicensing) senderCheck {
+	sender := instance.Spec.Sender
+	check := senderCheck{generation: instance.Generation, checkedAt: time.Now()}
+	reporterCheck := service.ReporterCheck{
+		ReporterURL:     sender.ReporterURL,
+		ValidateCerts:   sender.ValidateReporterCerts,
+		OutboundNetwork: instance.Spec.OutboundNetwork,
+	}
+	failedReason := ""
+
+	tokenSecretName := sender.ReporterSecretToken
+	if tokenSecretName == "" {
+		tokenSecretName = instance.Spec.GetDefaultReporterTokenName()
+	}
+	tokenSecret := corev1.Secret{}
+	if err := r.Reader.Get(ctx, types.NamespacedName{Namespace: instance.Spec.InstanceNamespace, Name: tokenSecretName}, &tokenSecret); err != nil {
+		check.diagnostics = append(check.diagnostics, describeSecretError("Reporter token", tokenSecretName, err))
+		failedReason = service.ReporterTokenMissingReason
+	} else if token, err := service.GetReporterToken(&tokenSecret); err != nil {
+		check.diagnostics = append(check.diagnostics, err.Error())
+		failedReason = service.ReporterTokenMissingReason
+	} else {
+		reporterCheck.Token = token
+	}
+
+	if sender.ValidateReporterCerts && sender.ReporterCertsSecretName != "" {
+		certsSecret := corev1.Secret{}
+		err := r.Reader.Get(ctx, types.NamespacedName{Namespace: instance.Spec.InstanceNamespace, Name: sender.ReporterCertsSecretName}, &certsSecret)
+		if err == nil {
+			reporterCheck.Certs, err = service.GetReporterCerts(&certsSecret, time.Now())
+			if err != nil {
+				check.diagnostics = append(check.diagnostics, err.Error())
+			}
+		} else {
+			check.diagnostics = append(check.diagnostics, describeSecretError("Reporter certificates", sender.ReporterCertsSecretName, err))
+		}
+		if err != nil && failedReason == "" {
+			failedReason = service.ReporterCertsInvalidReason
+		}
+	}
+
+	if outbound := instance.Spec.OutboundNetwork; sender.ValidateReporterCerts && outbound != nil && outbound.TrustedCABundle != "" {
+		bundle := corev1.ConfigMap{}
+		err := r.Reader.Get(ctx, types.NamespacedName{Namespace: instance.Spec.InstanceNamespace, Name: outbound.TrustedCABundle}, &bundle)
+		if err == nil {
+			reporterCheck.TrustedCAs = []byte(bundle.Data[service.TrustedCABundleKey])
+		} else {
+			check.diagnostics = append(check.diagnostics, fmt.Sprintf("Trusted CA bundle ConfigMap %s cannot be read: %v", outbound.TrustedCABundle, err))
+			if failedReason == "" {
+				failedReason = service.ReporterCertsInvalidReason
+			}
+		}
+	}
+
+	if failedReason != "" {
+		check.condition = metav1.Condition{
+			Type:    service.SenderReadyConditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  failedReason,
+			Message: check.diagnostics[0],
+		}
+	} else {
+		check.condition = service.CheckReporterConnectivity(ctx, reporterCheck)
+		if check.condition.Status != metav1.ConditionTrue {
+			check.diagnostics = append(check.diagnostics, check.condition.Message)
+		}
+	}
+	check.condition.ObservedGeneration = instance.Generation
+	return check
+}
+
+func describeSecretError(description, name string, err error) string {
+	if apierrors.IsNotFound(err) {
+		return fmt.Sprintf("%s secret %s not found in instance namespace", description, name)
+	}
+	return fmt.Sprintf("%s secret %s cannot be read: %v", description, name, err)
+}
+
+// Returns sender status of the instance and sets or removes its SenderReady condition
+func (r *IBMLicensingReconciler) getSenderStatus(instance *operatorv1alpha1.IBMLicensing, conditions *[]metav1.Condition) *operatorv1alpha1.IBMLicensingSenderStatus {
+	r.senderChecksMutex.Lock()
+	check, found := r.senderChecks[instance.UID]
+	r.senderChecksMutex.Unlock()
+
+	if instance.Spec.Sender == nil || !found {
+		meta.RemoveStatusCondition(conditions, service.SenderReadyConditionType)
+		return nil
+	}
+	meta.SetStatusCondition(conditions, check.condition)
+	return &operatorv1alpha1.IBMLicensingSenderStatus{
+		LastCheckTime: metav1.NewTime(check.checkedAt.Truncate(time.Second)),
+		Diagnostics:   check.diagnostics,
+	}
+}
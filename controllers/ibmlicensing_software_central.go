@@ -0,0 +1,86 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources/service"
+)
+
+/*
+Requeues the instance after the next scheduled upload to Software Central, so that the next upload time in status is
+refreshed. Invalid configuration is only reported in status by updateStatus.
+*/
+func (r *IBMLicensingReconciler) reconcileSoftwareCentralStatus(instance *operatorv1alpha1.IBMLicensing) (reconcile.Result, error) {
+	if !instance.Spec.IsSoftwareCentralEnabled() {
+		return reconcile.Result{}, nil
+	}
+	schedule, err := service.ParseSoftwareCentralFrequency(instance.Spec.SoftwareCentral)
+	if err != nil {
+		r.Log.Info("Software Central upload frequency is invalid", "error", err.Error())
+		return reconcile.Result{}, nil
+	}
+	now := time.Now().UTC()
+	return reconcile.Result{RequeueAfter: schedule.Next(now).Sub(now) + time.Second}, nil
+}
+
+// Returns Software Central status of the instance and sets or removes its SoftwareCentralReady condition
+func (r *IBMLicensingReconciler) getSoftwareCentralStatus(ctx context.Context, instance *operatorv1alpha1.IBMLicensing, instanceNamespace string,
+	conditions *[]metav1.Condition) *operatorv1alpha1.IBMLicensingSoftwareCentralStatus {
+	if !instance.Spec.IsSoftwareCentralEnabled() {
+		meta.RemoveStatusCondition(conditions, service.SoftwareCentralReadyConditionType)
+		return nil
+	}
+
+	status := &operatorv1alpha1.IBMLicensingSoftwareCentralStatus{}
+	var validationReason string
+	var validationErr error
+
+	secretName := instance.Spec.SoftwareCentral.EntitlementKeySecret
+	secret := corev1.Secret{}
+	if err := r.Reader.Get(ctx, types.NamespacedName{Namespace: instanceNamespace, Name: secretName}, &secret); err != nil {
+		validationReason = service.EntitlementKeyMissingReason
+		validationErr = errors.New(describeSecretError("Entitlement key", secretName, err))
+	} else if err := service.CheckEntitlementKey(&secret); err != nil {
+		validationReason = service.EntitlementKeyMissingReason
+		validationErr = err
+	}
+
+	if schedule, err := service.ParseSoftwareCentralFrequency(instance.Spec.SoftwareCentral); err != nil {
+		if validationErr == nil {
+			validationReason = service.InvalidUploadFrequencyReason
+			validationErr = err
+		}
+	} else {
+		status.NextUploadTime = &metav1.Time{Time: schedule.Next(time.Now().UTC())}
+	}
+
+	condition := service.GetSoftwareCentralCondition(validationReason, validationErr)
+	condition.ObservedGeneration = instance.Generation
+	meta.SetStatusCondition(conditions, condition)
+	return status
+}
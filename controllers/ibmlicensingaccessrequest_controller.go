@@ -0,0 +1,238 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+	svcres "github.com/IBM/ibm-licensing-operator/controllers/resources/service"
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/api/v1alpha1"
+)
+
+const (
+	AccessRequestPhasePending = "Pending"
+	AccessRequestPhaseReady   = "Ready"
+	AccessRequestPhaseFailed  = "Failed"
+
+	// Condition type reporting whether all requested bindings are shared
+	AccessRequestReadyCondition = "Ready"
+
+	// Field index of IBMLicensingAccessRequests by requested binding keys
+	accessRequestBindingKeyIndex = "accessRequestBindingKey"
+	// Requeue interval of access requests waiting for Secrets or ConfigMaps to be created by License Service
+	accessRequestPendingRequeue = 30 * time.Second
+)
+
+/*
+IBMLicensingAccessRequestReconciler shares License Service API access with namespaces of IBMLicensingAccessRequests,
+the same way as it is done for OperandRequests, but without the need for Operand Deployment Lifecycle Manager.
+*/
+type IBMLicensingAccessRequestReconciler struct {
+	client.Client
+	client.Reader
+	Log               logr.Logger
+	Scheme            *runtime.Scheme
+	OperatorNamespace string
+	// Operator configuration with the name of the ConfigMap with additional bindings, defaults are used if not set
+	Config *res.OperatorConfigStore
+}
+
+func (r *IBMLicensingAccessRequestReconciler) copier() *bindingCopier {
+	return &bindingCopier{
+		Client:            r.Client,
+		Reader:            r.Reader,
+		Scheme:            r.Scheme,
+		OperatorNamespace: r.OperatorNamespace,
+		BindingsConfigMap: r.Config.Get().BindingsConfigMap,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IBMLicensingAccessRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &operatorv1alpha1.IBMLicensingAccessRequest{}, accessRequestBindingKeyIndex,
+		func(obj client.Object) []string {
+			accessRequest, ok := obj.(*operatorv1alpha1.IBMLicensingAccessRequest)
+			if !ok {
+				return nil
+			}
+			if len(accessRequest.Spec.Bindings) == 0 {
+				return []string{allBindingsIndexValue}
+			}
+			return slices.Collect(maps.Keys(accessRequest.Spec.Bindings))
+		})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&operatorv1alpha1.IBMLicensingAccessRequest{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.accessRequestsForBindingSource(svcres.SecretKind))).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.accessRequestsForBindingSource(svcres.ConfigMapKind))).
+		Complete(r)
+}
+
+// Maps a Secret or ConfigMap from operator namespace to IBMLicensingAccessRequests with bindings sharing it
+func (r *IBMLicensingAccessRequestReconciler) accessRequestsForBindingSource(kind string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		bindings, err := r.copier().getBindingsUsingSource(ctx, kind, obj)
+		if err != nil {
+			r.Log.Error(err, "Cannot read bindings configuration", "ConfigMap", r.Config.Get().BindingsConfigMap)
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, binding := range bindings {
+			for _, indexValue := range []string{binding.Key, allBindingsIndexValue} {
+				accessRequests := operatorv1alpha1.IBMLicensingAccessRequestList{}
+				if err := r.Client.List(ctx, &accessRequests, client.MatchingFields{accessRequestBindingKeyIndex: indexValue}); err != nil {
+					r.Log.Error(err, "Cannot list IBMLicensingAccessRequests using binding", "binding", binding.Key)
+					continue
+				}
+				for _, accessRequest := range accessRequests.Items {
+					requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&accessRequest)})
+				}
+			}
+		}
+		return requests
+	}
+}
+
+// +kubebuilder:rbac:groups=operator.ibm.com,resources=ibmlicensingaccessrequests;ibmlicensingaccessrequests/finalizers;ibmlicensingaccessrequests/status,verbs=get;list;patch;update;watch
+// +kubebuilder:rbac:groups="",resources=configmaps;secrets,verbs=create;delete;get;list;patch;update;watch
+
+// Reconcile copies Secrets and ConfigMaps of bindings requested in IBMLicensingAccessRequest to its namespace and reports their state
+func (r *IBMLicensingAccessRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	reqLogger := r.Log.WithValues("ibmlicensingaccessrequest", req.NamespacedName)
+
+	accessRequest := operatorv1alpha1.IBMLicensingAccessRequest{}
+	if err := r.Client.Get(ctx, req.NamespacedName, &accessRequest); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Deleted request may have been the last one using API token issued for its namespace
+			return ctrl.Result{}, r.copier().pruneConsumerTokens(ctx, reqLogger)
+		}
+		return ctrl.Result{}, err
+	}
+	if !accessRequest.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	reqLogger.Info("Reconciling IBMLicensingAccessRequest")
+
+	bindings, err := r.copier().getBindings(ctx)
+	if err != nil {
+		reqLogger.Error(err, "Cannot read bindings configuration", "ConfigMap", r.Config.Get().BindingsConfigMap)
+		return ctrl.Result{}, err
+	}
+
+	requestedBindings := getAccessRequestBindings(accessRequest)
+
+	bindingCopies := svcres.GetBindingCopies(bindings, requestedBindings)
+	results, failed, requeue := r.copier().copyBindings(ctx, reqLogger, &accessRequest, bindingCopies)
+	for _, key := range svcres.GetUnknownBindingKeys(bindings, requestedBindings) {
+		results[key] = bindingResult{Reason: "UnknownBinding", Message: fmt.Sprintf("Binding %s is not provided by License Service", key)}
+		failed = true
+	}
+
+	if err := r.copier().pruneConsumerTokens(ctx, reqLogger); err != nil {
+		reqLogger.Error(err, "Cannot revoke API tokens of namespaces without requests")
+		return ctrl.Result{}, err
+	}
+
+	setAccessRequestStatus(&accessRequest, results, failed, requeue)
+	if err := r.Client.Status().Update(ctx, &accessRequest); err != nil {
+		reqLogger.Error(err, "Couldn't update IBMLicensingAccessRequest status")
+		return ctrl.Result{}, err
+	}
+
+	if requeue && !failed {
+		return ctrl.Result{RequeueAfter: accessRequestPendingRequeue}, nil
+	}
+	reqLogger.Info("reconcile all done")
+	return ctrl.Result{}, nil
+}
+
+// Returns bindings requested in IBMLicensingAccessRequest by key, in the format used by OperandRequests
+func getAccessRequestBindings(accessRequest operatorv1alpha1.IBMLicensingAccessRequest) map[string]odlm.SecretConfigmap {
+	requestedBindings := map[string]odlm.SecretConfigmap{}
+	for key, binding := range accessRequest.Spec.Bindings {
+		requestedBindings[key] = odlm.SecretConfigmap{Secret: binding.Secret, Configmap: binding.ConfigMap}
+	}
+	return requestedBindings
+}
+
+// Sets phase, per binding status and Ready condition of IBMLicensingAccessRequest based on results of copying bindings
+func setAccessRequestStatus(accessRequest *operatorv1alpha1.IBMLicensingAccessRequest, results map[string]bindingResult, failed, requeue bool) {
+	var notReady []string
+	bindingStatuses := make([]operatorv1alpha1.IBMLicensingAccessBindingStatus, 0, len(results))
+	for _, key := range slices.Sorted(maps.Keys(results)) {
+		bindingStatuses = append(bindingStatuses, operatorv1alpha1.IBMLicensingAccessBindingStatus{
+			Key:     key,
+			Ready:   results[key].Ready,
+			Reason:  results[key].Reason,
+			Message: results[key].Message,
+		})
+		if !results[key].Ready {
+			notReady = append(notReady, key)
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:               AccessRequestReadyCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             "BindingsShared",
+		Message:            "All requested bindings are shared",
+		ObservedGeneration: accessRequest.Generation,
+	}
+	switch {
+	case failed:
+		accessRequest.Status.Phase = AccessRequestPhaseFailed
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "BindingsFailed"
+		condition.Message = "Bindings not shared: " + strings.Join(notReady, ", ")
+	case requeue:
+		accessRequest.Status.Phase = AccessRequestPhasePending
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "BindingsPending"
+		condition.Message = "Waiting for License Service to create objects of bindings: " + strings.Join(notReady, ", ")
+	default:
+		accessRequest.Status.Phase = AccessRequestPhaseReady
+	}
+
+	accessRequest.Status.Bindings = bindingStatuses
+	accessRequest.Status.ObservedGeneration = accessRequest.Generation
+	meta.SetStatusCondition(&accessRequest.Status.Conditions, condition)
+}
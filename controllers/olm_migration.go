@@ -0,0 +1,458 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	operatorframeworkv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources/service"
+)
+
+/*
+OLMMigrator migrates License Service from OLM-based deployment to Helm-based deployment, replacing the cleanup Job of
+the helm-migration chart. It runs when the operator Deployment was taken over by a Helm release and:
+  - adopts IBMLicensing instances by the Helm release of the operator,
+  - deletes Subscriptions of the operator package and their ClusterServiceVersions without dependents right away, so
+    that OLM neither upgrades nor reverts the Deployment taken over by Helm,
+  - waits until the operator and License Service deployments are rolled out,
+  - releases objects taken over by Helm from their ClusterServiceVersion,
+  - deletes remaining objects created by OLM for these ClusterServiceVersions.
+
+Subscriptions and ClusterServiceVersions may also be deleted the same way before the Helm upgrade, as documented in the
+helm-migration chart, then only the remaining objects are cleaned up.
+
+Every step is idempotent and recorded in the status ConfigMap, so the migration is resumed after the operator restart.
+*/
+type OLMMigrator struct {
+	Client            client.Client
+	Reader            client.Reader
+	Log               logr.Logger
+	OperatorNamespace string
+	// Interval of retrying the migration until it is completed
+	Interval time.Duration
+}
+
+// Namespaced object with its kind, used to find objects created by OLM
+type olmMigrationObject struct {
+	kind string
+	obj  client.Object
+}
+
+func (o olmMigrationObject) String() string {
+	return o.kind + "/" + o.obj.GetName()
+}
+
+// +kubebuilder:rbac:namespace=ibm-licensing,groups=operators.coreos.com,resources=subscriptions;clusterserviceversions,verbs=get;list;delete
+// +kubebuilder:rbac:namespace=ibm-licensing,groups="",resources=serviceaccounts,verbs=get;list;watch;update;delete
+// +kubebuilder:rbac:namespace=ibm-licensing,groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;list;update;delete
+
+// NeedLeaderElection is true, as only the leader may modify resources
+func (m *OLMMigrator) NeedLeaderElection() bool {
+	return true
+}
+
+// Start runs the migration until it is completed or not needed, it implements manager.Runnable
+func (m *OLMMigrator) Start(ctx context.Context) error {
+	for {
+		done, err := m.migrate(ctx)
+		if err != nil {
+			m.Log.Error(err, "Migration from OLM-based deployment failed, it will be retried", "after", m.Interval)
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(m.Interval):
+		}
+	}
+}
+
+/*
+Runs the steps of the migration, which were not completed yet. Returns true if the migration is completed or not needed.
+*/
+func (m *OLMMigrator) migrate(ctx context.Context) (bool, error) {
+	status, err := res.GetOLMMigrationStatus(ctx, m.Reader, m.OperatorNamespace)
+	if err != nil {
+		return false, err
+	}
+	if status.Phase == res.OLMMigrationPhaseCompleted {
+		return true, nil
+	}
+
+	operatorDeployment := &appsv1.Deployment{}
+	if err := m.Reader.Get(ctx, types.NamespacedName{Namespace: m.OperatorNamespace, Name: res.OperatorName}, operatorDeployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			m.Log.Info("Operator Deployment not found, migration from OLM-based deployment skipped", "Deployment", res.OperatorName)
+			return true, nil
+		}
+		return false, err
+	}
+	if !res.IsHelmManaged(operatorDeployment) {
+		return true, nil
+	}
+
+	subscriptions, csvNames, err := m.findOLMInstallation(ctx)
+	if err != nil {
+		return false, m.fail(ctx, &status, err)
+	}
+	if status.StartTime == nil && len(subscriptions) == 0 && len(csvNames) == 0 {
+		// Operator was installed by Helm from the beginning
+		return true, nil
+	}
+	for _, csvName := range csvNames {
+		if !slices.Contains(status.ClusterServiceVersions, csvName) {
+			status.ClusterServiceVersions = append(status.ClusterServiceVersions, csvName)
+		}
+	}
+	slices.Sort(status.ClusterServiceVersions)
+	if status.StartTime == nil {
+		now := metav1.Now()
+		status.StartTime = &now
+		m.Log.Info("Migrating from OLM-based deployment", "ClusterServiceVersions", status.ClusterServiceVersions)
+	}
+
+	if !status.IsStepCompleted(res.OLMMigrationStepAdoptInstance) {
+		adopted, err := m.adoptInstances(ctx, operatorDeployment)
+		if err != nil {
+			return false, m.fail(ctx, &status, err)
+		}
+		if err := m.completeStep(ctx, &status, res.OLMMigrationStepAdoptInstance, adopted); err != nil {
+			return false, err
+		}
+	}
+
+	// OLM must stop managing the operator Deployment before waiting for its rollout
+	olmSteps := []struct {
+		name string
+		run  func() ([]string, error)
+	}{
+		{res.OLMMigrationStepDeleteSubscriptions, func() ([]string, error) {
+			return m.deleteSubscriptions(ctx, subscriptions)
+		}},
+		{res.OLMMigrationStepDeleteCSVs, func() ([]string, error) {
+			return m.deleteClusterServiceVersions(ctx, status.ClusterServiceVersions)
+		}},
+	}
+	for _, step := range olmSteps {
+		if status.IsStepCompleted(step.name) {
+			continue
+		}
+		status.Phase = res.OLMMigrationPhaseInProgress
+		status.Message = "Running step " + step.name
+		objects, err := step.run()
+		if err != nil {
+			return false, m.fail(ctx, &status, err)
+		}
+		if err := m.completeStep(ctx, &status, step.name, objects); err != nil {
+			return false, err
+		}
+	}
+
+	if healthy, message, err := m.isDeploymentHealthy(ctx, operatorDeployment); err != nil {
+		return false, m.fail(ctx, &status, err)
+	} else if !healthy {
+		m.Log.Info("Waiting for healthy deployment before removing OLM-based deployment", "reason", message)
+		status.Phase = res.OLMMigrationPhaseWaitingForHealthyDeployment
+		status.Message = message
+		return false, res.SaveOLMMigrationStatus(ctx, m.Client, m.Reader, m.OperatorNamespace, status)
+	}
+
+	steps := []struct {
+		name string
+		run  func() ([]string, error)
+	}{
+		{res.OLMMigrationStepReleaseAdoptedObjects, func() ([]string, error) {
+			return m.releaseAdoptedObjects(ctx, status.ClusterServiceVersions)
+		}},
+		{res.OLMMigrationStepDeleteOLMOwnedObjects, func() ([]string, error) {
+			return m.deleteOLMOwnedObjects(ctx, status.ClusterServiceVersions)
+		}},
+	}
+	for _, step := range steps {
+		if status.IsStepCompleted(step.name) {
+			continue
+		}
+		status.Phase = res.OLMMigrationPhaseInProgress
+		status.Message = "Running step " + step.name
+		objects, err := step.run()
+		if err != nil {
+			return false, m.fail(ctx, &status, err)
+		}
+		if err := m.completeStep(ctx, &status, step.name, objects); err != nil {
+			return false, err
+		}
+	}
+
+	now := metav1.Now()
+	status.Phase = res.OLMMigrationPhaseCompleted
+	status.Message = "License Service migrated from OLM-based deployment"
+	status.CompletionTime = &now
+	if err := res.SaveOLMMigrationStatus(ctx, m.Client, m.Reader, m.OperatorNamespace, status); err != nil {
+		return false, err
+	}
+	m.Log.Info("Migration from OLM-based deployment completed")
+	return true, nil
+}
+
+func (m *OLMMigrator) completeStep(ctx context.Context, status *res.OLMMigrationStatus, name string, objects []string) error {
+	status.CompleteStep(name, objects)
+	m.Log.Info("Migration step completed", "step", name, "objects", objects)
+	return res.SaveOLMMigrationStatus(ctx, m.Client, m.Reader, m.OperatorNamespace, *status)
+}
+
+// Records failure in the status ConfigMap and returns the original error
+func (m *OLMMigrator) fail(ctx context.Context, status *res.OLMMigrationStatus, err error) error {
+	status.Phase = res.OLMMigrationPhaseFailed
+	status.Message = err.Error()
+	if saveErr := res.SaveOLMMigrationStatus(ctx, m.Client, m.Reader, m.OperatorNamespace, *status); saveErr != nil {
+		m.Log.Error(saveErr, "Failed to save migration status", "ConfigMap", res.OLMMigrationStatusConfigMapName)
+	}
+	return err
+}
+
+/*
+Returns Subscriptions of the operator package in operator namespace and names of their ClusterServiceVersions, including
+ones labeled by OLM with the operator package and deleted ones, which objects created by OLM are still labeled with.
+Nothing is returned if OLM is not installed on the cluster.
+*/
+func (m *OLMMigrator) findOLMInstallation(ctx context.Context) ([]operatorframeworkv1alpha1.Subscription, []string, error) {
+	subscriptionList := operatorframeworkv1alpha1.SubscriptionList{}
+	if err := m.Reader.List(ctx, &subscriptionList, client.InNamespace(m.OperatorNamespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	var subscriptions []operatorframeworkv1alpha1.Subscription
+	var csvNames []string
+	for _, subscription := range subscriptionList.Items {
+		if subscription.Spec == nil || subscription.Spec.Package != res.OLMPackageName {
+			continue
+		}
+		subscriptions = append(subscriptions, subscription)
+		for _, csvName := range []string{subscription.Status.InstalledCSV, subscription.Status.CurrentCSV} {
+			if csvName != "" && !slices.Contains(csvNames, csvName) {
+				csvNames = append(csvNames, csvName)
+			}
+		}
+	}
+
+	csvList := operatorframeworkv1alpha1.ClusterServiceVersionList{}
+	operatorLabel := res.OLMOperatorLabelPrefix + res.OLMPackageName + "." + m.OperatorNamespace
+	if err := m.Reader.List(ctx, &csvList, client.InNamespace(m.OperatorNamespace), client.HasLabels{operatorLabel}); err != nil {
+		return nil, nil, err
+	}
+	for _, csv := range csvList.Items {
+		// CSVs copied by OLM to other namespaces are removed together with the original one
+		if csv.IsCopied() || slices.Contains(csvNames, csv.Name) {
+			continue
+		}
+		csvNames = append(csvNames, csv.Name)
+	}
+
+	// ClusterServiceVersions already deleted without their dependents, by the migrator or before the Helm upgrade
+	candidates, err := m.listOLMObjectCandidates(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, candidate := range candidates {
+		csvName := res.GetOwnerCSVName(candidate.obj)
+		if !strings.HasPrefix(csvName, res.OperatorName+".") || candidate.obj.GetLabels()[res.OLMOwnerNamespaceLabel] != m.OperatorNamespace ||
+			slices.Contains(csvNames, csvName) {
+			continue
+		}
+		csvNames = append(csvNames, csvName)
+	}
+	return subscriptions, csvNames, nil
+}
+
+// Adopts all IBMLicensing instances by the Helm release of the operator Deployment
+func (m *OLMMigrator) adoptInstances(ctx context.Context, operatorDeployment *appsv1.Deployment) ([]string, error) {
+	instanceList := operatorv1alpha1.IBMLicensingList{}
+	if err := m.Reader.List(ctx, &instanceList); err != nil {
+		return nil, err
+	}
+	var adopted []string
+	for i := range instanceList.Items {
+		instance := &instanceList.Items[i]
+		if !res.AdoptByHelmRelease(instance, operatorDeployment) {
+			continue
+		}
+		if err := m.Client.Update(ctx, instance); err != nil {
+			return nil, err
+		}
+		adopted = append(adopted, "IBMLicensing/"+instance.Name)
+	}
+	return adopted, nil
+}
+
+/*
+Checks that the operator Deployment and License Service Deployment of the active IBMLicensing instance are rolled out.
+Only the operator Deployment is checked if there is no active instance. Returns reason if they are not rolled out.
+*/
+func (m *OLMMigrator) isDeploymentHealthy(ctx context.Context, operatorDeployment *appsv1.Deployment) (bool, string, error) {
+	if !res.IsDeploymentRolledOut(operatorDeployment) {
+		return false, "Operator Deployment " + operatorDeployment.Name + " is not rolled out", nil
+	}
+
+	instanceList := operatorv1alpha1.IBMLicensingList{}
+	if err := m.Reader.List(ctx, &instanceList); err != nil {
+		return false, "", err
+	}
+	activeInstance := slices.IndexFunc(instanceList.Items, func(instance operatorv1alpha1.IBMLicensing) bool {
+		return instance.Status.State == service.ActiveCRState
+	})
+	if activeInstance < 0 {
+		m.Log.Info("No active IBMLicensing instance found, only operator Deployment is checked")
+		return true, "", nil
+	}
+	instance := &instanceList.Items[activeInstance]
+	instanceNamespace := instance.Spec.InstanceNamespace
+	if instanceNamespace == "" {
+		instanceNamespace = m.OperatorNamespace
+	}
+
+	licensingDeployment := &appsv1.Deployment{}
+	licensingDeploymentName := service.GetResourceName(instance)
+	if err := m.Reader.Get(ctx, types.NamespacedName{Namespace: instanceNamespace, Name: licensingDeploymentName}, licensingDeployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, "License Service Deployment " + licensingDeploymentName + " not found", nil
+		}
+		return false, "", err
+	}
+	if !res.IsDeploymentRolledOut(licensingDeployment) {
+		return false, "License Service Deployment " + licensingDeploymentName + " is not rolled out", nil
+	}
+	return true, "", nil
+}
+
+// Lists objects in operator namespace of kinds, which OLM creates for ClusterServiceVersions
+func (m *OLMMigrator) listOLMObjectCandidates(ctx context.Context) ([]olmMigrationObject, error) {
+	lists := []struct {
+		kind string
+		list client.ObjectList
+	}{
+		{"Deployment", &appsv1.DeploymentList{}},
+		{"ServiceAccount", &corev1.ServiceAccountList{}},
+		{"Role", &rbacv1.RoleList{}},
+		{"RoleBinding", &rbacv1.RoleBindingList{}},
+	}
+	var objects []olmMigrationObject
+	for _, candidates := range lists {
+		if err := m.Reader.List(ctx, candidates.list, client.InNamespace(m.OperatorNamespace)); err != nil {
+			return nil, err
+		}
+		err := meta.EachListItem(candidates.list, func(obj runtime.Object) error {
+			objects = append(objects, olmMigrationObject{kind: candidates.kind, obj: obj.(client.Object)})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return objects, nil
+}
+
+// Removes ClusterServiceVersion ownership and OLM labels from objects taken over by Helm, so that they are not deleted as leftovers
+func (m *OLMMigrator) releaseAdoptedObjects(ctx context.Context, csvNames []string) ([]string, error) {
+	candidates, err := m.listOLMObjectCandidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var released []string
+	for _, candidate := range candidates {
+		if !res.IsHelmManaged(candidate.obj) || !res.IsOwnedByCSV(candidate.obj, csvNames) {
+			continue
+		}
+		if !res.ReleaseFromOLM(candidate.obj) {
+			continue
+		}
+		if err := m.Client.Update(ctx, candidate.obj); err != nil {
+			return nil, err
+		}
+		released = append(released, candidate.String())
+	}
+	return released, nil
+}
+
+func (m *OLMMigrator) deleteSubscriptions(ctx context.Context, subscriptions []operatorframeworkv1alpha1.Subscription) ([]string, error) {
+	var deleted []string
+	for i := range subscriptions {
+		if err := m.Client.Delete(ctx, &subscriptions[i]); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		deleted = append(deleted, "Subscription/"+subscriptions[i].Name)
+	}
+	return deleted, nil
+}
+
+/*
+Deletes ClusterServiceVersions without their dependents, so that the running operator and objects taken over by Helm are
+kept. Objects created by OLM keep OLM owner labels, by which the remaining ones are found and deleted later.
+*/
+func (m *OLMMigrator) deleteClusterServiceVersions(ctx context.Context, csvNames []string) ([]string, error) {
+	var deleted []string
+	for _, csvName := range csvNames {
+		csv := &operatorframeworkv1alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: csvName, Namespace: m.OperatorNamespace},
+		}
+		if err := m.Client.Delete(ctx, csv, client.PropagationPolicy(metav1.DeletePropagationOrphan)); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		deleted = append(deleted, "ClusterServiceVersion/"+csvName)
+	}
+	return deleted, nil
+}
+
+// Deletes objects created by OLM for the ClusterServiceVersions, which were not taken over by Helm
+func (m *OLMMigrator) deleteOLMOwnedObjects(ctx context.Context, csvNames []string) ([]string, error) {
+	candidates, err := m.listOLMObjectCandidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var deleted []string
+	for _, candidate := range candidates {
+		if res.IsHelmManaged(candidate.obj) || !res.IsOwnedByCSV(candidate.obj, csvNames) {
+			continue
+		}
+		if err := m.Client.Delete(ctx, candidate.obj); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		deleted = append(deleted, candidate.String())
+	}
+	return deleted, nil
+}
@@ -0,0 +1,62 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/api/v1alpha1"
+)
+
+// BindInfoCleaner deletes ibm-licensing-bindinfo OperandBindInfo, which is replaced by bindings copied by the operator
+type BindInfoCleaner struct {
+	Client            client.Client
+	Reader            client.Reader
+	Log               logr.Logger
+	OperatorNamespace string
+}
+
+/*
+Start deletes the OperandBindInfo if its CRD exists, it implements manager.Runnable. Errors are only logged,
+as the leftover OperandBindInfo does not prevent the operator from working.
+*/
+func (c *BindInfoCleaner) Start(ctx context.Context) error {
+	bindInfoCrdExists, err := res.DoesCRDExist(c.Reader, &odlm.OperandBindInfoList{})
+	if err != nil {
+		c.Log.Error(err, "An error occurred while checking for OperandBindInfo CRD existence")
+		return nil
+	}
+	if !bindInfoCrdExists {
+		return nil
+	}
+	if err := res.DeleteBindInfoIfExists(ctx, c.Reader, c.Client, c.OperatorNamespace); err != nil {
+		c.Log.Error(err, "An error occurred while detecting and deleting "+res.LsBindInfoName)
+		return nil
+	}
+	c.Log.Info(res.LsBindInfoName + " deleted")
+	return nil
+}
+
+// NeedLeaderElection is true, as only the leader may modify resources
+func (c *BindInfoCleaner) NeedLeaderElection() bool {
+	return true
+}
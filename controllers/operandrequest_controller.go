@@ -19,27 +19,38 @@ package controllers
 import (
 	"context"
 	"fmt"
-	"regexp"
+	"maps"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
 	svcres "github.com/IBM/ibm-licensing-operator/controllers/resources/service"
 	odlm "github.com/IBM/operand-deployment-lifecycle-manager/api/v1alpha1"
 )
 
-var operandBindInfoInfix, _ = regexp.Compile(`^(.*)(opbi|bindinfo)(.*)$`)
+const (
+	// Field index of OperandRequests by requested binding keys
+	bindingKeyIndex = "licensingBindingKey"
+	// Index value of OperandRequests requesting all bindings
+	allBindingsIndexValue = "*"
+)
 
 // OperandRequestReconciler reconciles a OperandRequest object
 type OperandRequestReconciler struct {
@@ -48,21 +59,133 @@ type OperandRequestReconciler struct {
 	Log               logr.Logger
 	Scheme            *runtime.Scheme
 	OperatorNamespace string
+	// Operator configuration with the name of the ConfigMap with additional bindings, defaults are used if not set
+	Config *res.OperatorConfigStore
+}
+
+func (r *OperandRequestReconciler) copier() *bindingCopier {
+	return &bindingCopier{
+		Client:            r.Client,
+		Reader:            r.Reader,
+		Scheme:            r.Scheme,
+		OperatorNamespace: r.OperatorNamespace,
+		BindingsConfigMap: r.Config.Get().BindingsConfigMap,
+	}
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *OperandRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	if err := res.UpdateCacheClusterExtensions(mgr.GetAPIReader(), r.Log); err != nil {
-		r.Log.Error(err, "Error during checking K8s API")
+	if err := r.indexBindingKeys(context.Background(), mgr); err != nil {
+		return err
 	}
 
 	watcher := ctrl.NewControllerManagedBy(mgr).
-		For(&odlm.OperandRequest{}).
-		WithEventFilter(ignoreDeletionPredicate())
+		For(&odlm.OperandRequest{}, builder.WithPredicates(ignoreDeletionPredicate()))
+	for _, src := range r.bindingSourceWatches(mgr) {
+		watcher = watcher.WatchesRawSource(src)
+	}
 
 	return watcher.Complete(r)
 }
 
+/*
+Builds the controller, which is not added to the manager, so that it can be started and stopped at runtime,
+depending on availability of OperandRequest CRD.
+*/
+func (r *OperandRequestReconciler) newRunnables(ctx context.Context, mgr ctrl.Manager) ([]manager.Runnable, error) {
+	if err := r.indexBindingKeys(ctx, mgr); err != nil {
+		return nil, err
+	}
+
+	operandRequestController, err := controller.NewUnmanaged("operandrequest", controller.Options{
+		Reconciler:         r,
+		SkipNameValidation: ptr.To(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sources := append([]source.Source{
+		source.Kind[client.Object](mgr.GetCache(), &odlm.OperandRequest{}, &handler.EnqueueRequestForObject{}, ignoreDeletionPredicate()),
+	}, r.bindingSourceWatches(mgr)...)
+	for _, src := range sources {
+		if err := operandRequestController.Watch(src); err != nil {
+			return nil, err
+		}
+	}
+	return []manager.Runnable{operandRequestController}, nil
+}
+
+// Indexes OperandRequests by keys of ibm-licensing-operator bindings they request
+func (r *OperandRequestReconciler) indexBindingKeys(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(ctx, &odlm.OperandRequest{}, bindingKeyIndex, func(obj client.Object) []string {
+		operandRequest, ok := obj.(*odlm.OperandRequest)
+		if !ok {
+			return nil
+		}
+		return getRequestedBindingKeys(*operandRequest)
+	})
+}
+
+/*
+Returns keys of ibm-licensing-operator bindings requested in OperandRequest.
+OperandRequest requesting the operand without bindings gets all of them, which is indexed with allBindingsIndexValue.
+*/
+func getRequestedBindingKeys(operandRequest odlm.OperandRequest) []string {
+	var keys []string
+	requested := false
+	for _, request := range operandRequest.Spec.Requests {
+		for _, operand := range request.Operands {
+			if operand.Name == res.OperatorName {
+				requested = true
+				for key := range operand.Bindings {
+					keys = append(keys, key)
+				}
+			}
+		}
+	}
+	if requested && len(keys) == 0 {
+		return []string{allBindingsIndexValue}
+	}
+	return keys
+}
+
+// Watches Secrets and ConfigMaps shared by bindings, so that their copies are updated when the source changes
+func (r *OperandRequestReconciler) bindingSourceWatches(mgr ctrl.Manager) []source.Source {
+	return []source.Source{
+		source.Kind[client.Object](mgr.GetCache(), &corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.operandRequestsForBindingSource(svcres.SecretKind))),
+		source.Kind[client.Object](mgr.GetCache(), &corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.operandRequestsForBindingSource(svcres.ConfigMapKind))),
+	}
+}
+
+// Maps a Secret or ConfigMap from operator namespace to OperandRequests with bindings sharing it
+func (r *OperandRequestReconciler) operandRequestsForBindingSource(kind string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		bindings, err := r.copier().getBindingsUsingSource(ctx, kind, obj)
+		if err != nil {
+			r.Log.Error(err, "Cannot read bindings configuration", "ConfigMap", r.Config.Get().BindingsConfigMap)
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, binding := range bindings {
+			for _, indexValue := range []string{binding.Key, allBindingsIndexValue} {
+				operandRequests := odlm.OperandRequestList{}
+				if err := r.Client.List(ctx, &operandRequests, client.MatchingFields{bindingKeyIndex: indexValue}); err != nil {
+					r.Log.Error(err, "Cannot list OperandRequests using binding", "binding", binding.Key)
+					continue
+				}
+				for _, operandRequest := range operandRequests.Items {
+					requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&operandRequest)})
+				}
+			}
+		}
+		if len(requests) > 0 {
+			r.Log.Info("Binding source changed. Requeueing dependent OperandRequests", "kind", kind, "name", obj.GetName(), "count", len(requests))
+		}
+		return requests
+	}
+}
+
 func ignoreDeletionPredicate() predicate.Predicate {
 	return predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
@@ -136,269 +259,102 @@ func (r *OperandRequestReconciler) UpdateOperandRequestWithPhase(
 func (r *OperandRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	reqLogger := r.Log.WithValues("operandrequest", req.NamespacedName)
 
-	if err := res.UpdateCacheClusterExtensions(r.Reader, reqLogger); err != nil {
-		reqLogger.Error(err, "Error during checking K8s API")
-	}
-
 	// Fetch the OperandRequest instance
 	operandRequest := odlm.OperandRequest{}
 	if err := r.Client.Get(ctx, req.NamespacedName, &operandRequest); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Deleted OperandRequest may have been the last one using API token issued for its namespace
+			return ctrl.Result{}, r.copier().pruneConsumerTokens(ctx, reqLogger)
+		}
 		// Error reading the object - requeue the request.
-		return ctrl.Result{}, client.IgnoreNotFound(err)
+		return ctrl.Result{}, err
 	}
 
 	if isForLicensing := res.HasOperandRequestBindingForLicensing(operandRequest); !isForLicensing {
-		return ctrl.Result{}, nil
+		return ctrl.Result{}, r.copier().pruneConsumerTokens(ctx, reqLogger)
 	}
 
 	reqLogger.Info("Reconciling OperandRequest")
 
-	for _, request := range operandRequest.Spec.Requests {
-		var infoConfigMapName, tokenSecretName, tokenSecretName2, uploadConfigName, uploadTokenName string
-		var requeueTokenSec, requeueToken2Sec, requeueUploadSec, requeueInfoCm, requeueUploadCm bool
-		var err error
-		for _, operand := range request.Operands {
-			if operand.Name == res.OperatorName {
-				r.UpdateOperandRequestWithPhase(reqLogger, &operandRequest, odlm.ServiceCreating)
-
-				// In case something failed but requeue was not requested
-				operandRequestFailedCopy := false
-
-				for key, binding := range operand.Bindings {
-					if key == "public-api-data" {
-						infoConfigMapName = binding.Configmap
-						tokenSecretName = binding.Secret
-					}
-
-					if key == "public-api-token" {
-						tokenSecretName2 = binding.Secret
-					}
-
-					if key == "public-api-upload" {
-						uploadConfigName = binding.Configmap
-						uploadTokenName = binding.Secret
-					}
-				}
-
-				requeueTokenSec, err = r.copySecret(ctx, req, svcres.LicensingToken, tokenSecretName, r.OperatorNamespace, operandRequest.Namespace, &operandRequest)
-				if err != nil {
-					reqLogger.Error(err, "Cannot copy Secret", "name", svcres.LicensingToken, "namespace", operandRequest.Namespace)
-					operandRequestFailedCopy = true
-					r.UpdateOperandRequestWithPhase(reqLogger, &operandRequest, odlm.ServiceFailed)
-				}
-				if requeueTokenSec {
-					return reconcile.Result{Requeue: true}, err
-				}
-
-				requeueToken2Sec, err = r.copySecret(ctx, req, svcres.LicensingToken, tokenSecretName2, r.OperatorNamespace, operandRequest.Namespace, &operandRequest)
-				if err != nil {
-					reqLogger.Error(err, "Cannot copy Secret", "name", svcres.LicensingToken, "namespace", operandRequest.Namespace)
-					operandRequestFailedCopy = true
-					r.UpdateOperandRequestWithPhase(reqLogger, &operandRequest, odlm.ServiceFailed)
-				}
-				if requeueToken2Sec {
-					return reconcile.Result{Requeue: true}, err
-				}
+	bindings, err := r.copier().getBindings(ctx)
+	if err != nil {
+		reqLogger.Error(err, "Cannot read bindings configuration", "ConfigMap", r.Config.Get().BindingsConfigMap)
+		return ctrl.Result{}, err
+	}
 
-				requeueUploadSec, err = r.copySecret(ctx, req, svcres.LicensingUploadToken, uploadTokenName, r.OperatorNamespace, operandRequest.Namespace, &operandRequest)
-				if err != nil {
-					reqLogger.Error(err, "Cannot copy Secret", "name", svcres.LicensingUploadToken, "namespace", operandRequest.Namespace)
-					operandRequestFailedCopy = true
-					r.UpdateOperandRequestWithPhase(reqLogger, &operandRequest, odlm.ServiceFailed)
-				}
-				if requeueUploadSec {
-					return reconcile.Result{Requeue: true}, err
-				}
+	requestedBindings := getOperandRequestBindings(operandRequest)
 
-				requeueInfoCm, err = r.copyConfigMap(ctx, req, svcres.LicensingInfo, infoConfigMapName, r.OperatorNamespace, operandRequest.Namespace, &operandRequest)
-				if err != nil {
-					reqLogger.Error(err, "Cannot copy ConfigMap", svcres.LicensingInfo, "namespace", operandRequest.Namespace)
-					operandRequestFailedCopy = true
-					r.UpdateOperandRequestWithPhase(reqLogger, &operandRequest, odlm.ServiceFailed)
-				}
-				if requeueInfoCm {
-					return reconcile.Result{Requeue: true}, err
-				}
+	r.UpdateOperandRequestWithPhase(reqLogger, &operandRequest, odlm.ServiceCreating)
 
-				requeueUploadCm, err = r.copyConfigMap(ctx, req, svcres.LicensingUploadConfig, uploadConfigName, r.OperatorNamespace, operandRequest.Namespace, &operandRequest)
-				if err != nil {
-					reqLogger.Error(err, "Cannot copy ConfigMap", "name", svcres.LicensingUploadConfig, "namespace", operandRequest.Namespace)
-					operandRequestFailedCopy = true
-					r.UpdateOperandRequestWithPhase(reqLogger, &operandRequest, odlm.ServiceFailed)
-				}
-				if requeueUploadCm {
-					return reconcile.Result{Requeue: true}, err
-				}
+	bindingCopies := svcres.GetBindingCopies(bindings, requestedBindings)
+	results, operandRequestFailedCopy, requeue := r.copier().copyBindings(ctx, reqLogger, &operandRequest, bindingCopies)
+	setBindingConditions(&operandRequest, results)
+	if err := r.copier().pruneConsumerTokens(ctx, reqLogger); err != nil {
+		reqLogger.Error(err, "Cannot revoke API tokens of namespaces without requests")
+		operandRequestFailedCopy = true
+	}
 
-				// Set the status as Running - the operand request has provided all requested objects
-				// Any status could be chosen here, but to avoid confusion with updates/creation, Running is used
-				// IBM License Service Scanner is also expecting this status here when reconciling connection to License Service
-				if !operandRequestFailedCopy {
-					r.UpdateOperandRequestWithPhase(reqLogger, &operandRequest, odlm.ServiceRunning)
-				}
-			}
-		}
+	switch {
+	case operandRequestFailedCopy:
+		r.UpdateOperandRequestWithPhase(reqLogger, &operandRequest, odlm.ServiceFailed)
+	case requeue:
+		r.UpdateOperandRequestWithPhase(reqLogger, &operandRequest, odlm.ServiceCreating)
+		return reconcile.Result{Requeue: true}, nil
+	default:
+		// Set the status as Running - the operand request has provided all requested objects
+		// Any status could be chosen here, but to avoid confusion with updates/creation, Running is used
+		// IBM License Service Scanner is also expecting this status here when reconciling connection to License Service
+		r.UpdateOperandRequestWithPhase(reqLogger, &operandRequest, odlm.ServiceRunning)
 	}
 
 	reqLogger.Info("reconcile all done")
 	return ctrl.Result{}, nil
 }
 
-// Copy secret `sourceName` from source namespace `sourceNs` to target namespace `targetNs`
-func (r *OperandRequestReconciler) copySecret(ctx context.Context, req reconcile.Request, sourceName, targetName, sourceNs, targetNs string,
-	requestInstance *odlm.OperandRequest) (requeue bool, err error) {
-	reqLogger := r.Log.WithValues("operandrequest", req.NamespacedName)
-
-	if sourceName == "" || sourceNs == "" || targetNs == "" {
-		return false, nil
-	}
-
-	if sourceName == targetName && sourceNs == targetNs {
-		return false, nil
-	}
-
-	if targetName == "" {
-		targetName = res.LsBindInfoName + "-" + sourceName
-	}
-
-	secret := corev1.Secret{}
-	// Use Reader (bypasses label-filtered cache) because source secrets may not carry
-	// the "release=ibm-licensing-service" label required by the ByObject cache.
-	if err := r.Reader.Get(ctx, types.NamespacedName{Name: sourceName, Namespace: sourceNs}, &secret); err != nil {
-		if apierrors.IsNotFound(err) {
-			reqLogger.Info("Secret not found", "name", sourceName, "namespace", sourceNs)
-			return true, nil
-		}
-		reqLogger.Error(err, "failed to get Secret", "name", sourceName, "namespace", sourceNs)
-		return false, err
-	}
-	// Create the Secret to the OperandRequest namespace
-	secretLabel := make(map[string]string)
-	// Copy from the original labels to the target labels
-	for k, v := range secret.Labels {
-		if operandBindInfoInfix.MatchString(k) {
-			continue
-		}
-		secretLabel[k] = v
-	}
-
-	secretCopy := corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:        targetName,
-			Namespace:   targetNs,
-			Labels:      secretLabel,
-			Annotations: secret.Annotations,
-		},
-		Type:       secret.Type,
-		Data:       secret.Data,
-		StringData: secret.StringData,
-	}
-	// Set the OperandRequest as the controller of the Secret
-	if err := controllerutil.SetControllerReference(requestInstance, &secretCopy, r.Scheme); err != nil {
-		reqLogger.Error(err, "failed to set OperandRequest as the owner of Secret", "OperandRequestName", requestInstance.Name, "SecretName", secretCopy.Name, "namespace", targetNs)
-		return false, err
-	}
-
-	if err := r.Create(ctx, &secretCopy); err != nil {
-		if apierrors.IsAlreadyExists(err) {
-			// If already exist, update the Secret
-			existingSecret := corev1.Secret{}
-			// Use Reader (bypasses label-filtered cache) because the target secret may not yet
-			// carry the "release=ibm-licensing-service" label required by the ByObject cache.
-			if err := r.Reader.Get(ctx, types.NamespacedName{Namespace: targetNs, Name: targetName}, &existingSecret); err != nil {
-				reqLogger.Error(err, "failed to get Secret", "name", targetName, "namespace", targetNs)
-				return false, err
-			}
-			// Update existing Secret only if it has same name and set of labels
-			if needUpdate := !res.CompareSecretsData(&secretCopy, &existingSecret); needUpdate {
-				if err := r.Update(ctx, &secretCopy); err != nil {
-					reqLogger.Error(err, "failed to update Secret", "name", targetName, "namespace", targetNs)
-					return false, err
-				}
+// Returns bindings of ibm-licensing-operator requested in OperandRequest by key
+func getOperandRequestBindings(operandRequest odlm.OperandRequest) map[string]odlm.SecretConfigmap {
+	requestedBindings := map[string]odlm.SecretConfigmap{}
+	for _, request := range operandRequest.Spec.Requests {
+		for _, operand := range request.Operands {
+			if operand.Name == res.OperatorName {
+				maps.Copy(requestedBindings, operand.Bindings)
 			}
-		} else {
-			reqLogger.Error(err, "failed to create Secret", "name", targetName, "namespace", targetNs)
-			return false, err
 		}
 	}
-
-	return false, nil
+	return requestedBindings
 }
 
-// Copy configmap `sourceName` from namespace `sourceNs` to namespace `targetNs`
-// and rename it to `targetName`
-func (r *OperandRequestReconciler) copyConfigMap(ctx context.Context, req reconcile.Request, sourceName, targetName, sourceNs, targetNs string,
-	requestInstance *odlm.OperandRequest) (requeue bool, err error) {
-	reqLogger := r.Log.WithValues("operandrequest", req.NamespacedName)
-
-	if sourceName == "" || sourceNs == "" || targetNs == "" {
-		return false, nil
-	}
+// Replaces conditions of bindings in OperandRequest status, removing conditions of bindings which are no longer requested
+func setBindingConditions(operandRequest *odlm.OperandRequest, results map[string]bindingResult) {
+	bindingConditionPrefix := string(svcres.GetBindingConditionType(""))
+	now := time.Now().Format(time.RFC3339)
 
-	if sourceName == targetName && sourceNs == targetNs {
-		return false, nil
-	}
-
-	if targetName == "" {
-		targetName = res.LsBindInfoName + "-" + sourceName
-	}
-
-	cm := corev1.ConfigMap{}
-	if err := r.Client.Get(ctx, types.NamespacedName{Name: sourceName, Namespace: sourceNs}, &cm); err != nil {
-		if apierrors.IsNotFound(err) {
-			reqLogger.Info("Configmap not found", "name", sourceName, "namespace", sourceNs)
-			return true, nil
-		}
-		reqLogger.Error(err, "failed to get ConfigMap", "name", sourceName, "namespace", sourceNs)
-		return false, err
-	}
-	// Create the ConfigMap to the OperandRequest namespace
-	cmLabel := make(map[string]string)
-	// Copy from the original labels to the target labels
-	for k, v := range cm.Labels {
-		if operandBindInfoInfix.MatchString(k) {
+	conditions := []odlm.Condition{}
+	previousConditions := map[odlm.ConditionType]odlm.Condition{}
+	for _, condition := range operandRequest.Status.Conditions {
+		if strings.HasPrefix(string(condition.Type), bindingConditionPrefix) {
+			previousConditions[condition.Type] = condition
 			continue
 		}
-		cmLabel[k] = v
-	}
-
-	cmCopy := corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:        targetName,
-			Namespace:   targetNs,
-			Labels:      cmLabel,
-			Annotations: cm.Annotations,
-		},
-		Data:       cm.Data,
-		BinaryData: cm.BinaryData,
-	}
-	// Set the OperandRequest as the controller of the configmap
-	if err := controllerutil.SetControllerReference(requestInstance, &cmCopy, r.Scheme); err != nil {
-		reqLogger.Error(err, "failed to set OperandRequest as an owner of a ConfigMap", "OperandRequestName", requestInstance.Name, "ConfigMapName", cmCopy.Name, "namespace", targetNs)
-		return false, err
+		conditions = append(conditions, condition)
 	}
 
-	// Create the ConfigMap in the OperandRequest namespace
-	if err := r.Create(ctx, &cmCopy); err != nil {
-		if apierrors.IsAlreadyExists(err) {
-			// If already exist, update the ConfigMap
-			existingCm := corev1.ConfigMap{}
-			if err := r.Client.Get(ctx, types.NamespacedName{Namespace: targetNs, Name: targetName}, &existingCm); err != nil {
-				reqLogger.Error(err, "failed to get ConfigMap", "name", targetName, "namespace", targetNs)
-				return false, err
-			}
-			// Update existing ConfigMap only if it has same name and set of labels
-			if needUpdate := !res.CompareConfigMapData(&existingCm, &cmCopy); needUpdate {
-				if err := r.Update(ctx, &cmCopy); err != nil {
-					reqLogger.Error(err, "failed to update ConfigMap", "name", targetName, "namespace", targetNs)
-					return false, err
-				}
-			}
-		} else {
-			reqLogger.Error(err, "failed to create ConfigMap", "name", targetName, "namespace", targetNs)
-			return false, err
+	for _, key := range slices.Sorted(maps.Keys(results)) {
+		condition := odlm.Condition{
+			Type:    svcres.GetBindingConditionType(key),
+			Status:  corev1.ConditionFalse,
+			Reason:  results[key].Reason,
+			Message: results[key].Message,
 		}
+		if results[key].Ready {
+			condition.Status = corev1.ConditionTrue
+		}
+		condition.LastUpdateTime = now
+		condition.LastTransitionTime = now
+		if previous, found := previousConditions[condition.Type]; found && previous.Status == condition.Status {
+			condition.LastTransitionTime = previous.LastTransitionTime
+		}
+		conditions = append(conditions, condition)
 	}
-
-	return false, nil
+	operandRequest.Status.Conditions = conditions
 }
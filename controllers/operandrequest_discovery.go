@@ -22,86 +22,236 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	c "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
 	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources/service"
 
 	odlm "github.com/IBM/operand-deployment-lifecycle-manager/api/v1alpha1"
 )
 
-// Looks for OperandRequests (that have binding for ibm-licensing-operator) in other namespaces
+const (
+	operandRequestDiscoveryMinBackoff = time.Second
+	operandRequestDiscoveryMaxBackoff = 5 * time.Minute
+	// Retry interval used when the OperatorGroup is not (yet) present in operator namespace
+	operatorGroupMissingRequeue = 5 * time.Minute
+)
+
+// All events are mapped to the same request, as every reconciliation computes the complete list of namespaces
+var operandRequestDiscoveryRequest = reconcile.Request{NamespacedName: types.NamespacedName{Name: "operandrequest-discovery"}}
+
+// OperandRequestDiscoveryReconciler looks for OperandRequests (that have binding for ibm-licensing-operator)
+// in other namespaces and extends the IBMLicensing OperatorGroup with them.
+// OperandRequests and Namespaces are watched cluster-wide through a dedicated cache, as the manager cache is
+// restricted to the watched namespaces.
+type OperandRequestDiscoveryReconciler struct {
+	c.Client
+	c.Reader
+	Log               logr.Logger
+	OperatorNamespace string
+	WatchNamespaces   []string
+	// Applies additions of discovered namespaces to the OperatorGroup
+	OperatorGroupCoordinator *OperatorGroupCoordinator
+	// Operator configuration with the debounce of discovery events, defaults are used if not set
+	Config *res.OperatorConfigStore
+	// Duration of reconciliations reported for the liveness check
+	Heartbeats *res.Heartbeats
+
+	discoveryCache      cache.Cache
+	prevNssEnabledState *bool
+}
 
 // +kubebuilder:rbac:namespace=ibm-licensing,groups=operators.coreos.com,resources=operatorgroups,verbs=get;list;patch;update;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
-func DiscoverOperandRequests(logger *logr.Logger, writer c.Writer, reader c.Reader, watchNamespace []string, namespaceScopeSemaphore chan bool) {
-	var nssEnabled, prevNssEnabledState bool
-	var operandRequestList odlm.OperandRequestList
-	var namespaceListToExtend []string
+func (r *OperandRequestDiscoveryReconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	defer r.Heartbeats.Busy(operandRequestDiscoveryHeartbeat)()
 
-	operatorNamespace, err := res.GetOperatorNamespace()
+	nssEnabled, found, err := r.isNamespaceScopeEnabled(ctx)
 	if err != nil {
-		logger.Error(err, "Could not retrieve operator namespace. Discovering OperandRequests will be disabled")
-		return
-	}
-
-	// synchronize with reconciliation of IBMLicensing instance
-	nssEnabled = <-namespaceScopeSemaphore
-
-	for {
-		prevNssEnabledState = nssEnabled
-		select {
-		case nssEnabled = <-namespaceScopeSemaphore:
-			if nssEnabled != prevNssEnabledState {
-				if nssEnabled {
-					logger.Info("Namespace scope enabled. Cluster-wide discovering OperandRequests disabled")
-				} else {
-					logger.Info("Namespace scope disabled. Cluster-wide discovering OperandRequests enabled")
-				}
-			}
-		default:
+		return reconcile.Result{}, err
+	}
+	if !found {
+		// Reconciliation is triggered again once an IBMLicensing instance becomes active
+		r.Log.Info("No active IBMLicensing instance found. Waiting with discovering OperandRequests")
+		return reconcile.Result{}, nil
+	}
+	if r.prevNssEnabledState == nil || *r.prevNssEnabledState != nssEnabled {
+		if nssEnabled {
+			r.Log.Info("Namespace scope enabled. Cluster-wide discovering OperandRequests disabled")
+		} else {
+			r.Log.Info("Namespace scope disabled. Cluster-wide discovering OperandRequests enabled")
 		}
+		r.prevNssEnabledState = &nssEnabled
+	}
+	if nssEnabled {
+		return reconcile.Result{}, nil
+	}
 
-		if nssEnabled {
-			time.Sleep(30 * time.Second)
+	operandRequestList := odlm.OperandRequestList{}
+	if err := r.discoveryCache.List(ctx, &operandRequestList); err != nil {
+		r.Log.Error(err, "Could not list OperandRequests from cluster")
+		return reconcile.Result{}, err
+	}
+
+	namespaceListToExtend := []string{}
+	// OperandRequest triggering the addition of each namespace, recorded in the audit trail
+	operandRequestsByNamespace := map[string]string{}
+	var deniedChanges []res.OperatorGroupChange
+	for _, operandRequest := range operandRequestList.Items {
+		if !res.HasOperandRequestBindingForLicensing(operandRequest) {
 			continue
 		}
-
-		operandRequestList = odlm.OperandRequestList{}
-		err := reader.List(context.TODO(), &operandRequestList)
-		if err != nil {
-			logger.Error(err, "Could not list OperandRequests from cluster")
+		if slices.Contains(r.WatchNamespaces, operandRequest.Namespace) || operandRequestsByNamespace[operandRequest.Namespace] != "" {
+			continue
+		}
+		if !isOperandRequestNamespaceValid(&r.Log, r.discoveryCache, operandRequest) {
+			continue
+		}
+		operandRequestName := operandRequest.Namespace + "/" + operandRequest.Name
+		operandRequestsByNamespace[operandRequest.Namespace] = operandRequestName
+		if !r.OperatorGroupCoordinator.GetPolicy().IsNamespaceAllowed(operandRequest.Namespace) {
+			r.Log.Info("OperandRequest for "+res.OperatorName+" detected in namespace, which is not allowed to be added to IBMLicensing OperatorGroup", "OperandRequest", operandRequest.Name, "Namespace", operandRequest.Namespace)
+			deniedChanges = append(deniedChanges, res.OperatorGroupChange{
+				Time:           metav1.Now(),
+				Action:         res.OperatorGroupNamespaceDenied,
+				Namespace:      operandRequest.Namespace,
+				Reason:         "Namespace is not allowed by the OperatorGroup policy",
+				OperandRequest: operandRequestName,
+			})
+			continue
 		}
+		r.Log.Info("OperandRequest for "+res.OperatorName+" detected. IBMLicensing OperatorGroup will be extended", "OperandRequest", operandRequest.Name, "Namespace", operandRequest.Namespace)
+		namespaceListToExtend = append(namespaceListToExtend, operandRequest.Namespace)
+	}
+
+	r.recordOperatorGroupChanges(ctx, deniedChanges)
+
+	// Namespaces already present in the OperatorGroup are skipped by the coordinator
+	var addedChanges []res.OperatorGroupChange
+	for _, namespace := range namespaceListToExtend {
+		addedChanges = append(addedChanges, res.OperatorGroupChange{
+			Time:           metav1.Now(),
+			Action:         res.OperatorGroupNamespaceAdded,
+			Namespace:      namespace,
+			Reason:         "OperandRequest with binding for " + res.OperatorName + " found in namespace",
+			OperandRequest: operandRequestsByNamespace[namespace],
+		})
+	}
+	r.OperatorGroupCoordinator.Request(addedChanges...)
+	return reconcile.Result{}, nil
+}
 
-		namespaceListToExtend = []string{}
-		for _, operandRequest := range operandRequestList.Items {
-			if !isOperandRequestNamespaceValid(logger, reader, operandRequest) {
-				continue
-			}
-			if hasBinding := res.HasOperandRequestBindingForLicensing(operandRequest); hasBinding {
-				if !slices.Contains(watchNamespace, operandRequest.Namespace) && !slices.Contains(namespaceListToExtend, operandRequest.Namespace) {
-					logger.Info("OperandRequest for "+res.OperatorName+" detected. IBMLicensing OperatorGroup will be extended", "OperandRequest", operandRequest.Name, "Namespace", operandRequest.Namespace)
-					namespaceListToExtend = append(namespaceListToExtend, operandRequest.Namespace)
-				}
-			}
+// Failure to record the audit trail is only logged, so that it never blocks changes of the OperatorGroup
+func (r *OperandRequestDiscoveryReconciler) recordOperatorGroupChanges(ctx context.Context, changes []res.OperatorGroupChange) {
+	if err := res.RecordOperatorGroupChanges(ctx, r.Client, r.Reader, r.OperatorNamespace, changes); err != nil {
+		r.Log.Error(err, "Failed to record changes of IBMLicensing OperatorGroup", "ConfigMap", res.OperatorGroupAuditConfigMapName)
+	}
+}
+
+/*
+Returns namespace scope setting of the active IBMLicensing instance.
+Second returned value is false when there is no active instance yet.
+*/
+func (r *OperandRequestDiscoveryReconciler) isNamespaceScopeEnabled(ctx context.Context) (bool, bool, error) {
+	instanceList := operatorv1alpha1.IBMLicensingList{}
+	if err := r.Client.List(ctx, &instanceList); err != nil {
+		r.Log.Error(err, "Could not list IBMLicensing instances")
+		return false, false, err
+	}
+	for _, instance := range instanceList.Items {
+		if instance.Status.State == service.ActiveCRState {
+			return instance.Spec.IsNamespaceScopeEnabled(), true, nil
 		}
+	}
+	return false, false, nil
+}
 
-		if len(namespaceListToExtend) > 0 {
-			licensingOperatorGroup, err := res.GetLicensingOperatorGroupInNamespace(reader, operatorNamespace)
-			if err != nil {
-				logger.Error(err, "An error occurred while retrieving IBMLicensing OperatorGroup")
-			} else if licensingOperatorGroup != nil {
-				logger.Info("Extending IBMLicensing OperatorGroup with namespaces", "OperatorGroup", licensingOperatorGroup.Name, "NamespaceList", namespaceListToExtend)
-				licensingOperatorGroup = res.ExtendOperatorGroupWithNamespaceList(namespaceListToExtend, licensingOperatorGroup)
-				err := writer.Update(context.TODO(), licensingOperatorGroup)
-				if err != nil {
-					logger.Error(err, "An error occurred while extending IBMLicensing OperatorGroup", "OperatorGroup", licensingOperatorGroup.Name, "Namespace", operatorNamespace)
-				}
-			} else {
-				logger.Info("OperatorGroup for IBMLicensing operator not found", "Namespace", operatorNamespace)
-			}
+/*
+Builds the controller, which is not added to the manager, so that it can be stopped when ODLM is removed from the cluster.
+It is returned together with the dedicated cache of OperandRequests and Namespaces and the runnable monitoring
+reconciliations for the liveness check, which are stopped together with the controller.
+*/
+func (r *OperandRequestDiscoveryReconciler) newRunnables(_ context.Context, mgr ctrl.Manager) ([]manager.Runnable, error) {
+	discoveryCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme: mgr.GetScheme(),
+		Mapper: mgr.GetRESTMapper(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.discoveryCache = discoveryCache
+	r.prevNssEnabledState = nil
+
+	discoveryController, err := controller.NewUnmanaged("operandrequest-discovery", controller.Options{
+		Reconciler:              r,
+		MaxConcurrentReconciles: 1,
+		RateLimiter: workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](
+			operandRequestDiscoveryMinBackoff, operandRequestDiscoveryMaxBackoff),
+		SkipNameValidation: ptr.To(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sources := []source.Source{
+		source.Kind(discoveryCache, &odlm.OperandRequest{},
+			debouncedDiscoveryHandler(r.Config, func(o *odlm.OperandRequest) bool {
+				return res.HasOperandRequestBindingForLicensing(*o)
+			})),
+		source.Kind(discoveryCache, &corev1.Namespace{},
+			debouncedDiscoveryHandler(r.Config, func(ns *corev1.Namespace) bool {
+				return ns.Status.Phase == corev1.NamespaceActive
+			})),
+		source.Kind(mgr.GetCache(), &operatorv1alpha1.IBMLicensing{},
+			debouncedDiscoveryHandler(r.Config, func(*operatorv1alpha1.IBMLicensing) bool { return true })),
+	}
+	for _, src := range sources {
+		if err := discoveryController.Watch(src); err != nil {
+			return nil, err
 		}
+	}
+	heartbeats := manager.RunnableFunc(func(ctx context.Context) error {
+		r.Heartbeats.Register(operandRequestDiscoveryHeartbeat, heartbeatTimeout)
+		<-ctx.Done()
+		r.Heartbeats.Unregister(operandRequestDiscoveryHeartbeat)
+		return nil
+	})
+	return []manager.Runnable{discoveryCache, discoveryController, heartbeats}, nil
+}
 
-		time.Sleep(30 * time.Second)
+/*
+Returns event handler, which enqueues the single discovery request with a delay, if the object is relevant.
+Events occurring within the delay (operandRequestDiscovery.debounce of the operator configuration) are merged by the workqueue
+into one reconciliation, so that a burst of OperandRequest or Namespace changes results in a single request to the coordinator.
+*/
+func debouncedDiscoveryHandler[T c.Object](config *res.OperatorConfigStore, relevant func(T) bool) handler.TypedEventHandler[T, reconcile.Request] {
+	enqueue := func(obj T, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+		if relevant(obj) {
+			q.AddAfter(operandRequestDiscoveryRequest, config.Get().OperandRequestDiscovery.Debounce.Duration)
+		}
+	}
+	return handler.TypedFuncs[T, reconcile.Request]{
+		CreateFunc: func(_ context.Context, e event.TypedCreateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(e.Object, q)
+		},
+		UpdateFunc: func(_ context.Context, e event.TypedUpdateEvent[T], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(e.ObjectNew, q)
+		},
 	}
 }
 
@@ -0,0 +1,99 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+)
+
+/*
+OperatorConfigWatcher reloads the operator configuration from the ConfigMap in operator namespace. A new configuration
+replaces the current one in the Store only if it is valid, and IBMLicensing instances are requeued, so that changes
+of the License Service image or certificate renewal window are applied. Changes of the fields used only at startup
+are reported in the log.
+*/
+type OperatorConfigWatcher struct {
+	Reader            client.Reader
+	Log               logr.Logger
+	OperatorNamespace string
+	ConfigMapName     string
+	Store             *res.OperatorConfigStore
+	// Applied to every loaded configuration, f.e. to respect command line flags
+	Overrides func(*res.OperatorConfig)
+	// Interval of reading the ConfigMap
+	Interval time.Duration
+
+	IBMLicensingReconciler *IBMLicensingReconciler
+}
+
+// +kubebuilder:rbac:namespace=ibm-licensing,groups="",resources=configmaps,verbs=get;list;watch
+
+/*
+Start reloads the configuration until ctx is cancelled, it implements manager.Runnable. It is run together with controllers,
+so it reloads the configuration immediately to apply changes made before the replica became the leader.
+*/
+func (w *OperatorConfigWatcher) Start(ctx context.Context) error {
+	w.reload(ctx)
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.reload(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection is true, as the watcher requeues instances reconciled only by the leader
+func (w *OperatorConfigWatcher) NeedLeaderElection() bool {
+	return true
+}
+
+func (w *OperatorConfigWatcher) reload(ctx context.Context) {
+	config, err := res.GetOperatorConfig(ctx, w.Reader, w.OperatorNamespace, w.ConfigMapName, w.Overrides)
+	if err != nil {
+		w.Log.Error(err, "Cannot reload operator configuration, the current one is kept", "ConfigMap", w.ConfigMapName)
+		return
+	}
+	previous := w.Store.Get()
+	if reflect.DeepEqual(previous, config) {
+		return
+	}
+
+	if restartRequired := res.GetRestartRequiredChanges(previous, config); len(restartRequired) > 0 {
+		w.Log.Info("Operator configuration changes will be applied after the operator restart", "fields", restartRequired)
+	}
+	if err := config.ApplyLicensingImage(); err != nil {
+		w.Log.Error(err, "Cannot apply License Service image from operator configuration")
+		return
+	}
+	w.Store.Set(config)
+	w.Log.Info("Operator configuration reloaded", "ConfigMap", w.ConfigMapName)
+
+	if err := w.IBMLicensingReconciler.RequeueAllInstances(ctx); err != nil {
+		w.Log.Error(err, "Cannot requeue IBMLicensing instances after reloading operator configuration")
+	}
+}
@@ -18,38 +18,61 @@ package controllers
 
 import (
 	"context"
-	"fmt"
-	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
 )
 
-func RunRemoveStaleNamespacesFromOperatorGroupTask(ctx context.Context, logger *logr.Logger, client client.Client, reader client.Reader) {
-	// Immediately run the task once before starting the ticker loop
-	logger.Info("Running task of removing stale namespaces from OperatorGroup")
-	removeStaleNamespacesFromOperatorGroup(logger, client, reader)
+// StaleNamespacesCleaner periodically requests removal of namespaces, which no longer exist, from the OperatorGroup
+type StaleNamespacesCleaner struct {
+	Reader      client.Reader
+	Log         logr.Logger
+	Coordinator *OperatorGroupCoordinator
+	// Progress of the task reported for the liveness check
+	Heartbeats *res.Heartbeats
+}
 
-	ticker := time.NewTicker(time.Hour) // runs every hour
+// Start runs the task until ctx is cancelled, it implements manager.Runnable
+func (c *StaleNamespacesCleaner) Start(ctx context.Context) error {
+	c.Heartbeats.Register(staleNamespacesTaskHeartbeat, heartbeatTimeout)
+	defer c.Heartbeats.Unregister(staleNamespacesTaskHeartbeat)
+	heartbeatTicker := time.NewTicker(heartbeatInterval)
+	defer heartbeatTicker.Stop()
+
+	// Immediately run the task once before starting the ticker loop
+	c.Log.Info("Running task of removing stale namespaces from OperatorGroup")
+	removeStaleNamespacesFromOperatorGroup(&c.Log, c.Reader, c.Coordinator)
 
+	// Interval is read before every run, so that changes of the operator configuration are applied
+	timer := time.NewTimer(c.Coordinator.Config.Get().OperatorGroup.CleanupInterval.Duration)
+	defer timer.Stop()
 	for {
 		select {
-		case <-ticker.C:
-			logger.Info("Running task of removing stale namespaces from OperatorGroup")
-			removeStaleNamespacesFromOperatorGroup(logger, client, reader)
+		case <-heartbeatTicker.C:
+			c.Heartbeats.Beat(staleNamespacesTaskHeartbeat)
+		case <-timer.C:
+			c.Log.Info("Running task of removing stale namespaces from OperatorGroup")
+			removeStaleNamespacesFromOperatorGroup(&c.Log, c.Reader, c.Coordinator)
+			c.Heartbeats.Beat(staleNamespacesTaskHeartbeat)
+			timer.Reset(c.Coordinator.Config.Get().OperatorGroup.CleanupInterval.Duration)
 		case <-ctx.Done():
-			logger.Info("Stopping task of removing stale namespaces from OperatorGroup")
-			ticker.Stop()
-			return
+			c.Log.Info("Stopping task of removing stale namespaces from OperatorGroup")
+			return nil
 		}
 	}
 }
 
+// NeedLeaderElection is true, as only the leader may modify the OperatorGroup
+func (c *StaleNamespacesCleaner) NeedLeaderElection() bool {
+	return true
+}
+
 /*
 Periodically checks and updates the targetNamespaces field in the OperatorGroup.
 
@@ -60,33 +83,32 @@ to reconcile resources properly.
 
 To prevent such errors, this function periodically verifies whether the namespaces listed in targetNamespaces
 still exist in the cluster. If any namespaces are found to be missing, they are removed from the targetNamespaces
-list. This update causes the operator to restart and watch only existing namespaces.
+list by the OperatorGroup coordinator, together with other pending changes. This update causes the operator to restart
+and watch only existing namespaces.
 */
-func removeStaleNamespacesFromOperatorGroup(logger *logr.Logger, client client.Client, reader client.Reader) {
+func removeStaleNamespacesFromOperatorGroup(logger *logr.Logger, reader client.Reader, coordinator *OperatorGroupCoordinator) {
 	watchNamespaces, err := res.GetWatchNamespaceAsList()
 	if err != nil {
 		logger.Error(err, "Unable to get WATCH_NAMESPACE")
 		return
 	}
-	operatorNamespace, err := res.GetOperatorNamespace()
-	if err != nil {
-		logger.Error(err, "Unable to get OPERATOR_NAMESPACE")
-		return
-	}
 
-	var namespacesToRemove []string
+	var changes []res.OperatorGroupChange
 	for _, ns := range watchNamespaces {
 		if namespaceActive, err := namespaceActive(reader, ns); err != nil {
 			logger.Error(err, "Failed to check namespace existence: "+ns)
 			return
 		} else if !namespaceActive {
-			namespacesToRemove = append(namespacesToRemove, ns)
+			changes = append(changes, res.OperatorGroupChange{
+				Time:      metav1.Now(),
+				Action:    res.OperatorGroupNamespaceRemoved,
+				Namespace: ns,
+				Reason:    "Namespace does not exist or is terminating",
+			})
 			logger.Info("Namespace does not exist or is terminating: " + ns + " Namespace marked for removal.")
 		}
 	}
-	if err = removeNamespaceFromOperatorGroup(logger, client, reader, operatorNamespace, namespacesToRemove); err != nil {
-		logger.Error(err, "Failed to remove stale namespaces from OperatorGroup")
-	}
+	coordinator.Request(changes...)
 }
 
 /*
@@ -102,39 +124,3 @@ func namespaceActive(reader client.Reader, ns string) (bool, error) {
 	}
 	return namespace.Status.Phase == corev1.NamespaceActive, nil
 }
-
-/*
-Looks for OperatorGroup from operator's namespace.
-Removes given namespace from targetNamespaces list field if it contains the namespace.
-*/
-func removeNamespaceFromOperatorGroup(logger *logr.Logger, cli client.Client, reader client.Reader, namespace string, namespacesToRemove []string) error {
-	licensingOperatorGroup, err := res.GetLicensingOperatorGroupInNamespace(reader, namespace)
-	if err != nil {
-		logger.Error(err, "An error occurred while retrieving IBMLicensing OperatorGroup")
-		return err
-	} else if licensingOperatorGroup == nil {
-		logger.Info("OperatorGroup not found in namespace " + namespace)
-		return nil
-	}
-
-	targetNamespaces := licensingOperatorGroup.Spec.TargetNamespaces
-	var updatedNamespaces []string
-
-	for _, ns := range targetNamespaces {
-		if !slices.Contains(namespacesToRemove, ns) {
-			updatedNamespaces = append(updatedNamespaces, ns)
-		}
-	}
-
-	if len(updatedNamespaces) == len(targetNamespaces) {
-		return nil
-	}
-
-	licensingOperatorGroup.Spec.TargetNamespaces = updatedNamespaces
-	if err := cli.Update(context.Background(), licensingOperatorGroup); err != nil {
-		return fmt.Errorf("failed to update OperatorGroup %s: %v", licensingOperatorGroup.Name, err)
-	}
-	logger.Info("Removed stale namespaces from OperatorGroup: " + licensingOperatorGroup.Name)
-
-	return nil
-}
@@ -0,0 +1,223 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+)
+
+// Retry interval used when the OperatorGroup cannot be read or updated
+const operatorGroupUpdateRetry = 30 * time.Second
+
+/*
+OperatorGroupCoordinator is the only writer of the licensing OperatorGroup targetNamespaces. Every update makes OLM
+restart the operator, so additions and removals requested by operandrequest-discovery and the stale namespaces task
+are collected and applied in a single update, at most once per operatorGroup.updateInterval of the operator configuration.
+*/
+type OperatorGroupCoordinator struct {
+	Client            client.Client
+	Reader            client.Reader
+	Log               logr.Logger
+	OperatorNamespace string
+	// Operator configuration with the OperatorGroup policy and the minimal time between two updates, defaults are used if not set
+	Config *res.OperatorConfigStore
+	// Progress of the coordinator loop reported for the liveness check
+	Heartbeats *res.Heartbeats
+
+	mutex sync.Mutex
+	// Pending change by namespace, the latest request for a namespace replaces the previous one
+	pending map[string]res.OperatorGroupChange
+	trigger chan struct{}
+}
+
+// GetPolicy returns the current restrictions of OperatorGroup changes
+func (c *OperatorGroupCoordinator) GetPolicy() res.OperatorGroupPolicy {
+	return c.Config.Get().GetOperatorGroupPolicy()
+}
+
+func (c *OperatorGroupCoordinator) minInterval() time.Duration {
+	return c.Config.Get().OperatorGroup.UpdateInterval.Duration
+}
+
+// Request adds changes to the pending ones, which are applied with the next update of the OperatorGroup
+func (c *OperatorGroupCoordinator) Request(changes ...res.OperatorGroupChange) {
+	if len(changes) == 0 {
+		return
+	}
+	c.mutex.Lock()
+	if c.pending == nil {
+		c.pending = map[string]res.OperatorGroupChange{}
+	}
+	for _, change := range changes {
+		c.pending[change.Namespace] = change
+	}
+	c.mutex.Unlock()
+
+	select {
+	case c.getTrigger() <- struct{}{}:
+	default:
+	}
+}
+
+func (c *OperatorGroupCoordinator) getTrigger() chan struct{} {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.trigger == nil {
+		c.trigger = make(chan struct{}, 1)
+	}
+	return c.trigger
+}
+
+// Start applies pending changes until ctx is cancelled, it implements manager.Runnable
+func (c *OperatorGroupCoordinator) Start(ctx context.Context) error {
+	c.Log.Info("Starting OperatorGroup coordinator", "minInterval", c.minInterval(), "dryRun", c.GetPolicy().DryRun)
+	c.Heartbeats.Register(operatorGroupCoordinatorHeartbeat, heartbeatTimeout)
+	defer c.Heartbeats.Unregister(operatorGroupCoordinatorHeartbeat)
+	heartbeatTicker := time.NewTicker(heartbeatInterval)
+	defer heartbeatTicker.Stop()
+	trigger := c.getTrigger()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			c.Log.Info("Stopping OperatorGroup coordinator")
+			return nil
+		case <-heartbeatTicker.C:
+			c.Heartbeats.Beat(operatorGroupCoordinatorHeartbeat)
+			continue
+		case <-trigger:
+		case <-timer.C:
+		}
+		if wait := c.flush(ctx); wait > 0 {
+			timer.Reset(wait)
+		}
+		c.Heartbeats.Beat(operatorGroupCoordinatorHeartbeat)
+	}
+}
+
+// NeedLeaderElection is true, as only the leader may modify the OperatorGroup
+func (c *OperatorGroupCoordinator) NeedLeaderElection() bool {
+	return true
+}
+
+func (c *OperatorGroupCoordinator) pendingChanges() []res.OperatorGroupChange {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var changes []res.OperatorGroupChange
+	for _, namespace := range slices.Sorted(maps.Keys(c.pending)) {
+		changes = append(changes, c.pending[namespace])
+	}
+	return changes
+}
+
+// Removes processed changes, unless they were replaced by a newer request in the meantime
+func (c *OperatorGroupCoordinator) clearPending(processed []res.OperatorGroupChange) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, change := range processed {
+		if pending, found := c.pending[change.Namespace]; found && pending == change {
+			delete(c.pending, change.Namespace)
+		}
+	}
+}
+
+/*
+Applies all pending changes in one update of the OperatorGroup, retried on conflicts. Returns time to wait before the
+next attempt, or zero if there is nothing left to apply.
+*/
+func (c *OperatorGroupCoordinator) flush(ctx context.Context) time.Duration {
+	changes := c.pendingChanges()
+	if len(changes) == 0 {
+		return 0
+	}
+
+	operatorGroup, err := res.GetLicensingOperatorGroupInNamespace(c.Reader, c.OperatorNamespace)
+	if err != nil {
+		c.Log.Error(err, "An error occurred while retrieving IBMLicensing OperatorGroup")
+		return operatorGroupUpdateRetry
+	}
+	if operatorGroup == nil {
+		c.Log.Info("OperatorGroup for IBMLicensing operator not found", "Namespace", c.OperatorNamespace)
+		return operatorGroupMissingRequeue
+	}
+	policy := c.GetPolicy()
+	if wait := time.Until(res.GetOperatorGroupLastUpdate(operatorGroup).Add(c.minInterval())); wait > 0 && !policy.DryRun {
+		c.Log.Info("Update of IBMLicensing OperatorGroup postponed", "pendingChanges", len(changes), "after", wait.Round(time.Second))
+		return wait
+	}
+
+	var applied []res.OperatorGroupChange
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if operatorGroup == nil {
+			// OperatorGroup is read again after a conflict
+			if operatorGroup, err = res.GetLicensingOperatorGroupInNamespace(c.Reader, c.OperatorNamespace); err != nil {
+				return err
+			} else if operatorGroup == nil {
+				return fmt.Errorf("OperatorGroup for IBMLicensing operator not found in namespace %s", c.OperatorNamespace)
+			}
+		}
+		var targetNamespaces []string
+		targetNamespaces, applied = res.ApplyOperatorGroupChanges(operatorGroup.Spec.TargetNamespaces, changes)
+		if len(applied) == 0 || policy.DryRun {
+			return nil
+		}
+		operatorGroup.Spec.TargetNamespaces = targetNamespaces
+		if operatorGroup.Annotations == nil {
+			operatorGroup.Annotations = map[string]string{}
+		}
+		operatorGroup.Annotations[res.OperatorGroupLastUpdateAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		err := c.Client.Update(ctx, operatorGroup)
+		operatorGroup = nil
+		return err
+	})
+	if err != nil {
+		c.Log.Error(err, "An error occurred while updating IBMLicensing OperatorGroup")
+		return operatorGroupUpdateRetry
+	}
+	c.clearPending(changes)
+
+	if len(applied) > 0 {
+		for i := range applied {
+			applied[i].DryRun = policy.DryRun
+		}
+		if policy.DryRun {
+			c.Log.Info("Dry-run: IBMLicensing OperatorGroup would be updated", "changes", len(applied))
+		} else {
+			c.Log.Info("Updated IBMLicensing OperatorGroup", "changes", len(applied))
+		}
+		if err := res.RecordOperatorGroupChanges(ctx, c.Client, c.Reader, c.OperatorNamespace, applied); err != nil {
+			c.Log.Error(err, "Failed to record changes of IBMLicensing OperatorGroup", "ConfigMap", res.OperatorGroupAuditConfigMapName)
+		}
+	}
+
+	if len(c.pendingChanges()) > 0 {
+		return c.minInterval()
+	}
+	return 0
+}
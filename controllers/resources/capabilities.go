@@ -0,0 +1,170 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+)
+
+// Capabilities describes which optional APIs are available in the cluster
+type Capabilities struct {
+	RouteAPI            bool
+	ServiceCAAPI        bool
+	RHMP                bool
+	ODLM                bool
+	GatewayAPI          bool
+	BackendTLSPolicyAPI bool
+	ClusterProxyAPI     bool
+}
+
+// IsOCPCluster returns true if OpenShift specific APIs are available
+func (c Capabilities) IsOCPCluster() bool {
+	return c.RouteAPI || c.ServiceCAAPI
+}
+
+// IsAlertingEnabledByDefault returns true if alerting should be enabled when not configured in IBMLicensing
+func (c Capabilities) IsAlertingEnabledByDefault() bool {
+	return c.ServiceCAAPI
+}
+
+// API resource, which availability is checked by discovery, and the capability it enables
+type capabilityResource struct {
+	groupVersion string
+	resource     string
+	set          func(*Capabilities, bool)
+}
+
+var capabilityResources = []capabilityResource{
+	{"marketplace.redhat.com/v1beta1", "meterdefinitions", func(c *Capabilities, v bool) { c.RHMP = v }},
+	{"route.openshift.io/v1", "routes", func(c *Capabilities, v bool) { c.RouteAPI = v }},
+	{"operator.openshift.io/v1", "servicecas", func(c *Capabilities, v bool) { c.ServiceCAAPI = v }},
+	{"operator.ibm.com/v1alpha1", "operandbindinfos", func(c *Capabilities, v bool) { c.ODLM = v }},
+	{"gateway.networking.k8s.io/v1", "gateways", func(c *Capabilities, v bool) { c.GatewayAPI = v }},
+	{"gateway.networking.k8s.io/v1", "backendtlspolicies", func(c *Capabilities, v bool) { c.BackendTLSPolicyAPI = v }},
+	{"config.openshift.io/v1", "proxies", func(c *Capabilities, v bool) { c.ClusterProxyAPI = v }},
+}
+
+/*
+ClusterCapabilities detects optional APIs available in the cluster using the discovery API.
+Detected values are cached until the next Refresh, which is called periodically by the capabilities controller
+and whenever a related CustomResourceDefinition changes. It is safe for concurrent use.
+*/
+type ClusterCapabilities struct {
+	discoveryClient discovery.DiscoveryInterface
+	logger          logr.Logger
+
+	mutex        sync.RWMutex
+	capabilities Capabilities
+	// Error of the last refresh, nil if it succeeded
+	refreshErr error
+}
+
+// NewClusterCapabilities returns ClusterCapabilities with no capabilities detected until the first Refresh
+func NewClusterCapabilities(discoveryClient discovery.DiscoveryInterface, logger logr.Logger) *ClusterCapabilities {
+	return &ClusterCapabilities{discoveryClient: discoveryClient, logger: logger, refreshErr: fmt.Errorf("cluster capabilities not detected yet")}
+}
+
+// NewStaticClusterCapabilities returns ClusterCapabilities with fixed values, which are never refreshed. Used in tests.
+func NewStaticClusterCapabilities(capabilities Capabilities) *ClusterCapabilities {
+	return &ClusterCapabilities{capabilities: capabilities}
+}
+
+// Get returns a snapshot of the detected capabilities
+func (c *ClusterCapabilities) Get() Capabilities {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.capabilities
+}
+
+func (c *ClusterCapabilities) IsRouteAPI() bool            { return c.Get().RouteAPI }
+func (c *ClusterCapabilities) IsServiceCAAPI() bool        { return c.Get().ServiceCAAPI }
+func (c *ClusterCapabilities) IsRHMP() bool                { return c.Get().RHMP }
+func (c *ClusterCapabilities) IsODLM() bool                { return c.Get().ODLM }
+func (c *ClusterCapabilities) IsGatewayAPI() bool          { return c.Get().GatewayAPI }
+func (c *ClusterCapabilities) IsBackendTLSPolicyAPI() bool { return c.Get().BackendTLSPolicyAPI }
+func (c *ClusterCapabilities) IsClusterProxyAPI() bool     { return c.Get().ClusterProxyAPI }
+func (c *ClusterCapabilities) IsOCPCluster() bool          { return c.Get().IsOCPCluster() }
+
+// ReadyCheck fails if the last detection of capabilities failed, it is used as the readiness check of the operator
+func (c *ClusterCapabilities) ReadyCheck(_ *http.Request) error {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if c.refreshErr != nil {
+		return fmt.Errorf("cluster capabilities detection failed: %w", c.refreshErr)
+	}
+	return nil
+}
+
+/*
+Refresh detects capabilities using the discovery API and returns true if any of them changed.
+If an API group cannot be checked, previously detected values of its capabilities are kept and the error is returned.
+*/
+func (c *ClusterCapabilities) Refresh(_ context.Context) (bool, error) {
+	if c.discoveryClient == nil {
+		return false, nil
+	}
+
+	previous := c.Get()
+	detected := previous
+	var firstErr error
+	resourcesByGroupVersion := map[string][]string{}
+	failedGroupVersions := map[string]bool{}
+	for _, capability := range capabilityResources {
+		if failedGroupVersions[capability.groupVersion] {
+			continue
+		}
+		resources, checked := resourcesByGroupVersion[capability.groupVersion]
+		if !checked {
+			resourceList, err := c.discoveryClient.ServerResourcesForGroupVersion(capability.groupVersion)
+			if err != nil && !apierrors.IsNotFound(err) {
+				c.logger.Error(err, "Could not discover API group, keeping previously detected capabilities", "groupVersion", capability.groupVersion)
+				failedGroupVersions[capability.groupVersion] = true
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			resources = []string{}
+			if err == nil {
+				for _, apiResource := range resourceList.APIResources {
+					resources = append(resources, apiResource.Name)
+				}
+			}
+			resourcesByGroupVersion[capability.groupVersion] = resources
+		}
+		capability.set(&detected, slices.Contains(resources, capability.resource))
+	}
+
+	c.mutex.Lock()
+	c.capabilities = detected
+	c.refreshErr = firstErr
+	c.mutex.Unlock()
+
+	if detected != previous {
+		c.logger.Info("Cluster capabilities detected", "routeAPI", detected.RouteAPI, "serviceCAAPI", detected.ServiceCAAPI,
+			"rhmp", detected.RHMP, "odlm", detected.ODLM, "gatewayAPI", detected.GatewayAPI, "backendTLSPolicyAPI", detected.BackendTLSPolicyAPI)
+	}
+	return detected != previous, firstErr
+}
@@ -0,0 +1,129 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package resources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func apiResourceList(groupVersion string, resources ...string) *metav1.APIResourceList {
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for _, resource := range resources {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Name: resource})
+	}
+	return list
+}
+
+func TestClusterCapabilitiesRefresh(t *testing.T) {
+	fakeClient := &clienttesting.Fake{}
+	fakeClient.Resources = []*metav1.APIResourceList{
+		apiResourceList("route.openshift.io/v1", "routes"),
+		apiResourceList("operator.openshift.io/v1", "servicecas"),
+		apiResourceList("operator.ibm.com/v1alpha1", "ibmlicensings"),
+		apiResourceList("gateway.networking.k8s.io/v1", "gateways", "httproutes"),
+	}
+	capabilities := NewClusterCapabilities(&fakediscovery.FakeDiscovery{Fake: fakeClient}, logr.Discard())
+
+	t.Log("Given the need to detect cluster capabilities with discovery API")
+	{
+		if capabilities.ReadyCheck(nil) != nil {
+			t.Logf("\t%s\tShould not be ready before the first detection", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould not be ready before the first detection", FAIL)
+		}
+
+		t.Log("\tTest 0:\tWhen APIs are detected for the first time")
+		{
+			changed, err := capabilities.Refresh(context.Background())
+			if err != nil {
+				t.Fatalf("\t%s\tShould refresh capabilities : %v", FAIL, err)
+			}
+			expected := Capabilities{RouteAPI: true, ServiceCAAPI: true, GatewayAPI: true}
+			if changed && capabilities.Get() == expected {
+				t.Logf("\t%s\tShould detect available APIs and report a change", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould detect available APIs and report a change : %+v", FAIL, capabilities.Get())
+			}
+			if capabilities.IsOCPCluster() && capabilities.Get().IsAlertingEnabledByDefault() {
+				t.Logf("\t%s\tShould recognize OpenShift cluster", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould recognize OpenShift cluster", FAIL)
+			}
+			if err := capabilities.ReadyCheck(nil); err == nil {
+				t.Logf("\t%s\tShould be ready", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould be ready : %v", FAIL, err)
+			}
+		}
+
+		t.Log("\tTest 1:\tWhen APIs did not change")
+		{
+			changed, err := capabilities.Refresh(context.Background())
+			if err == nil && !changed {
+				t.Logf("\t%s\tShould not report a change", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould not report a change : %v", FAIL, err)
+			}
+		}
+
+		t.Log("\tTest 2:\tWhen discovery API fails")
+		{
+			fakeClient.PrependReactor("get", "resource", func(clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New("discovery failed")
+			})
+			changed, err := capabilities.Refresh(context.Background())
+			if err == nil {
+				t.Errorf("\t%s\tShould return an error", FAIL)
+			}
+			if !changed && capabilities.IsRouteAPI() && capabilities.IsGatewayAPI() {
+				t.Logf("\t%s\tShould keep previously detected capabilities", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould keep previously detected capabilities : %+v", FAIL, capabilities.Get())
+			}
+			if capabilities.ReadyCheck(nil) != nil {
+				t.Logf("\t%s\tShould not be ready", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould not be ready", FAIL)
+			}
+		}
+	}
+}
+
+func TestStaticClusterCapabilities(t *testing.T) {
+	t.Log("Given the need to supply fixed capabilities in tests")
+	{
+		t.Log("\tTest 0:\tWhen static capabilities are refreshed")
+		{
+			expected := Capabilities{RHMP: true, ODLM: true}
+			capabilities := NewStaticClusterCapabilities(expected)
+			changed, err := capabilities.Refresh(context.Background())
+			if err == nil && !changed && capabilities.Get() == expected && !capabilities.IsOCPCluster() {
+				t.Logf("\t%s\tShould keep provided capabilities", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould keep provided capabilities : %+v", FAIL, capabilities.Get())
+			}
+		}
+	}
+}
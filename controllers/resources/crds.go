@@ -19,11 +19,6 @@ package resources
 import (
 	"context"
 	"errors"
-	"os"
-	"reflect"
-	"time"
-
-	"github.com/go-logr/logr"
 
 	meta "k8s.io/apimachinery/pkg/api/meta"
 	c "sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,16 +44,3 @@ func DoesCRDExist(reader c.Reader, foundRes c.ObjectList) (bool, error) {
 	}
 	return true, nil
 }
-
-// Restarts operator if specified CRD appears on the cluster
-func RestartOnCRDCreation(logger *logr.Logger, reader c.Reader, foundRes c.ObjectList, reconcileInterval time.Duration) {
-	resType := reflect.TypeOf(foundRes)
-	reqLogger := logger.WithValues("action", "Checking for "+resType.String()+" CRD existence")
-	for {
-		if isCrdExists, _ := DoesCRDExist(reader, foundRes); isCrdExists {
-			reqLogger.Info(resType.String() + " CRD found on cluster. Operator will be restarted to enable handling it")
-			os.Exit(0)
-		}
-		time.Sleep(reconcileInterval)
-	}
-}
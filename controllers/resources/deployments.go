@@ -17,11 +17,18 @@
 package resources
 
 import (
+	"context"
+	"fmt"
 	"sort"
+	"time"
 
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apieq "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	c "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // To make linter happy
@@ -178,3 +185,14 @@ func equalEnvVars(envVarArr1, envVarArr2 []corev1.EnvVar) bool {
 	}
 	return true
 }
+
+// RolloutRestartDeployment restarts pods of the deployment in the same way as kubectl rollout restart
+func RolloutRestartDeployment(ctx context.Context, client c.Client, deploymentNsName types.NamespacedName) error {
+	data := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":"%s"}}}}}`, time.Now().String())
+	return client.Patch(ctx, &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: deploymentNsName.Namespace,
+			Name:      deploymentNsName.Name,
+		},
+	}, c.RawPatch(types.MergePatchType, []byte(data)))
+}
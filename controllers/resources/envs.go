@@ -49,6 +49,17 @@ func GetWatchNamespaceAsList() ([]string, error) {
 	return strings.Split(ns, ","), nil
 }
 
+// SplitList returns non-empty trimmed elements of comma-separated list
+func SplitList(list string) []string {
+	var elements []string
+	for _, element := range strings.Split(list, ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+	return elements
+}
+
 // GetOperatorNamespace returns the Namespace the operator should be watching for changes.
 func GetOperatorNamespace() (string, error) {
 	// OperatorNamespaceEnvVar is the constant for env variable OPERATOR_NAMESPACE
@@ -0,0 +1,110 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package resources
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+/*
+Heartbeats records when background tasks of the operator last reported progress and is used as the liveness check.
+A loop reports with Beat on every iteration, while a task processing events only when they occur marks the time it is
+busy with Busy, so that it is not reported as stuck when idle. A task is stuck if it is not idle and has not reported
+for longer than its timeout. Methods of nil Heartbeats do nothing.
+*/
+type Heartbeats struct {
+	mutex sync.Mutex
+	tasks map[string]*heartbeat
+	now   func() time.Time
+}
+
+type heartbeat struct {
+	timeout time.Duration
+	last    time.Time
+	idle    bool
+}
+
+func NewHeartbeats() *Heartbeats {
+	return &Heartbeats{tasks: map[string]*heartbeat{}, now: time.Now}
+}
+
+// Register starts monitoring of the task, which must report at least once per timeout
+func (h *Heartbeats) Register(name string, timeout time.Duration) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.tasks[name] = &heartbeat{timeout: timeout, last: h.now()}
+}
+
+// Unregister stops monitoring of the task, f.e. when it is stopped
+func (h *Heartbeats) Unregister(name string) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	delete(h.tasks, name)
+}
+
+// Beat records progress of the registered task
+func (h *Heartbeats) Beat(name string) {
+	h.update(name, false)
+}
+
+// Busy records the task started processing, the returned function marks it idle again
+func (h *Heartbeats) Busy(name string) func() {
+	h.update(name, false)
+	return func() {
+		h.update(name, true)
+	}
+}
+
+func (h *Heartbeats) update(name string, idle bool) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if task, found := h.tasks[name]; found {
+		task.last = h.now()
+		task.idle = idle
+	}
+}
+
+// Check fails if any registered task is stuck, it implements healthz.Checker
+func (h *Heartbeats) Check(_ *http.Request) error {
+	if h == nil {
+		return nil
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	now := h.now()
+	for _, name := range slices.Sorted(maps.Keys(h.tasks)) {
+		task := h.tasks[name]
+		if since := now.Sub(task.last); !task.idle && since > task.timeout {
+			return fmt.Errorf("%s has not reported progress for %s", name, since.Round(time.Second))
+		}
+	}
+	return nil
+}
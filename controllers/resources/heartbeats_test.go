@@ -0,0 +1,94 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package resources
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHeartbeats(t *testing.T) {
+	now := time.Now()
+	heartbeats := NewHeartbeats()
+	heartbeats.now = func() time.Time { return now }
+	heartbeats.Register("loop", time.Minute)
+	heartbeats.Register("reconciler", time.Minute)
+
+	t.Log("Given the need to detect stuck background tasks")
+	{
+		t.Log("\tTest 0:\tWhen tasks report progress")
+		{
+			now = now.Add(50 * time.Second)
+			heartbeats.Beat("loop")
+			done := heartbeats.Busy("reconciler")
+			now = now.Add(50 * time.Second)
+			heartbeats.Beat("loop")
+			done()
+			now = now.Add(50 * time.Second)
+			if err := heartbeats.Check(nil); err == nil {
+				t.Logf("\t%s\tShould be healthy", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould be healthy : %v", FAIL, err)
+			}
+		}
+
+		t.Log("\tTest 1:\tWhen idle task does not report")
+		{
+			now = now.Add(time.Hour)
+			heartbeats.Beat("loop")
+			if err := heartbeats.Check(nil); err == nil {
+				t.Logf("\t%s\tShould be healthy", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould be healthy : %v", FAIL, err)
+			}
+		}
+
+		t.Log("\tTest 2:\tWhen tasks are stuck")
+		{
+			heartbeats.Busy("reconciler")
+			now = now.Add(2 * time.Minute)
+			heartbeats.Beat("loop")
+			if err := heartbeats.Check(nil); err != nil {
+				t.Logf("\t%s\tShould report stuck reconciliation : %v", SUCCESS, err)
+			} else {
+				t.Errorf("\t%s\tShould report stuck reconciliation", FAIL)
+			}
+
+			heartbeats.Unregister("reconciler")
+			now = now.Add(2 * time.Minute)
+			if err := heartbeats.Check(nil); err != nil {
+				t.Logf("\t%s\tShould report stuck loop : %v", SUCCESS, err)
+			} else {
+				t.Errorf("\t%s\tShould report stuck loop", FAIL)
+			}
+
+			heartbeats.Unregister("loop")
+			if err := heartbeats.Check(nil); err == nil {
+				t.Logf("\t%s\tShould ignore unregistered tasks", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould ignore unregistered tasks : %v", FAIL, err)
+			}
+		}
+	}
+
+	var disabled *Heartbeats
+	disabled.Register("loop", time.Minute)
+	disabled.Busy("loop")()
+	if disabled.Check(nil) != nil {
+		t.Errorf("\t%s\tShould ignore nil heartbeats", FAIL)
+	}
+}
@@ -33,12 +33,9 @@ import (
 	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
 
 	"github.com/go-logr/logr"
-	servicecav1 "github.com/openshift/api/operator/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
-	rhmp "github.com/IBM/ibm-licensing-operator/pkg/rhmp/v1beta1"
-
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	apieq "k8s.io/apimachinery/pkg/api/equality"
@@ -49,9 +46,6 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	c "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
-	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
-
-	odlm "github.com/IBM/operand-deployment-lifecycle-manager/api/v1alpha1"
 )
 
 const (
@@ -67,14 +61,6 @@ var (
 	DefaultSecretMode int32 = 420
 	Seconds60         int64 = 60
 
-	IsRouteAPI                 = true
-	IsServiceCAAPI             = true
-	IsAlertingEnabledByDefault = true
-	RHMPEnabled                = false
-	IsODLM                     = true
-	IsGatewayAPI               = false
-	IsBackendTLSPolicyAPI      = false
-
 	PathType = networkingv1.PathTypeImplementationSpecific
 )
 
@@ -129,8 +115,8 @@ func AnnotationsForPod(instance *operatorv1alpha1.IBMLicensing) map[string]strin
 	})
 }
 
-func AnnotateForService(instance *operatorv1alpha1.IBMLicensing, certName string) map[string]string {
-	if IsServiceCAAPI && instance.Spec.HTTPSEnable {
+func AnnotateForService(instance *operatorv1alpha1.IBMLicensing, certName string, capabilities Capabilities) map[string]string {
+	if capabilities.ServiceCAAPI && instance.Spec.HTTPSEnable {
 		return mergeWithSpecAnnotations(instance, map[string]string{ocpCertSecretNameTag: certName})
 	}
 	return mergeWithSpecAnnotations(instance, map[string]string{})
@@ -410,80 +396,6 @@ echo "$(date): All required secrets exist"
 	return script
 }
 
-func UpdateCacheClusterExtensions(client c.Reader, logger logr.Logger) error {
-	namespace, err := GetOperatorNamespace()
-	if err != nil {
-		return errors.New("OPERATOR_NAMESPACE env not found")
-	}
-
-	listOpts := []c.ListOption{
-		c.InNamespace(namespace),
-	}
-
-	MeterDefinitionCRD := &rhmp.MeterDefinitionList{}
-	if err := client.List(context.TODO(), MeterDefinitionCRD, listOpts...); err == nil {
-		RHMPEnabled = true
-	} else {
-		RHMPEnabled = false
-	}
-
-	routeTestInstance := &routev1.RouteList{}
-	if err := client.List(context.TODO(), routeTestInstance, listOpts...); err == nil {
-		IsRouteAPI = true
-	} else {
-		IsRouteAPI = false
-	}
-
-	serviceCAInstance := &servicecav1.ServiceCAList{}
-	if err := client.List(context.TODO(), serviceCAInstance, listOpts...); err == nil {
-		IsServiceCAAPI = true
-		IsAlertingEnabledByDefault = true
-	} else {
-		IsServiceCAAPI = false
-		IsAlertingEnabledByDefault = false
-	}
-
-	odlmTestInstance := &odlm.OperandBindInfoList{}
-	if err := client.List(context.TODO(), odlmTestInstance, listOpts...); err == nil {
-		IsODLM = true
-	} else {
-		IsODLM = false
-	}
-	// Check for Gateway API by attempting to list Gateway resources
-	// If the CRD is not installed, this will return NoMatchError
-	gatewayTestInstance := &gatewayv1.GatewayList{}
-
-	if err = client.List(context.TODO(), gatewayTestInstance, listOpts...); err == nil {
-		IsGatewayAPI = true
-		if !IsRouteAPI && !IsServiceCAAPI {
-			logger.Info("Gateway API available in cluster")
-		}
-	} else {
-		IsGatewayAPI = false
-		if metaErrors.IsNoMatchError(err) {
-			if !IsRouteAPI && !IsServiceCAAPI {
-				logger.Info("Gateway API CRDs not found in cluster. Gateway routing features will be disabled.")
-			}
-		} else {
-			logger.Error(err, "Unexpected error checking for Gateway API, defaulting to disabled")
-		}
-	}
-
-	backendTLSPolicyTestInstance := &gatewayv1.BackendTLSPolicyList{}
-	if err := client.List(context.TODO(), backendTLSPolicyTestInstance, listOpts...); err == nil {
-		IsBackendTLSPolicyAPI = true
-	} else {
-		if metaErrors.IsNoMatchError(err) {
-			IsBackendTLSPolicyAPI = false
-		} else {
-			// Assume available for non-NoMatch errors
-			IsBackendTLSPolicyAPI = true
-		}
-	}
-
-	return nil
-}
-
 // MapHasAllPairsFromOther checks if all key, value pairs present in the second param are in the first param
 func MapHasAllPairsFromOther[K, V comparable](checked, allNeededPairs map[K]V) bool {
 	for key, value := range allNeededPairs {
@@ -0,0 +1,57 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestIsLeaseHeld(t *testing.T) {
+	now := time.Now()
+	lease := &coordinationv1.Lease{
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To("ibm-licensing-operator-1"),
+			LeaseDurationSeconds: ptr.To[int32](15),
+			RenewTime:            &metav1.MicroTime{Time: now.Add(-10 * time.Second)},
+		},
+	}
+
+	t.Log("Given the need to check if the operator has a leader")
+	{
+		if IsLeaseHeld(lease, now) {
+			t.Logf("\t%s\tShould be held when renewed within lease duration", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould be held when renewed within lease duration", FAIL)
+		}
+		if !IsLeaseHeld(lease, now.Add(10*time.Second)) {
+			t.Logf("\t%s\tShould not be held when not renewed within lease duration", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould not be held when not renewed within lease duration", FAIL)
+		}
+		lease.Spec.HolderIdentity = ptr.To("")
+		if !IsLeaseHeld(lease, now) {
+			t.Logf("\t%s\tShould not be held when released", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould not be held when released", FAIL)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package resources
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	c "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigMap in operator namespace with progress of the migration from OLM-based to Helm-based deployment
+	OLMMigrationStatusConfigMapName = "ibm-licensing-olm-migration"
+	OLMMigrationStatusConfigMapKey  = "status.yaml"
+
+	// Name of the OLM package, under which the operator is installed by Subscriptions
+	OLMPackageName = "ibm-licensing-operator-app"
+
+	// Metadata set by Helm on objects of a release, also on objects taken over with --take-ownership
+	HelmReleaseNameAnnotation      = "meta.helm.sh/release-name"
+	HelmReleaseNamespaceAnnotation = "meta.helm.sh/release-namespace"
+	HelmManagedByLabel             = "app.kubernetes.io/managed-by"
+	HelmManagedByValue             = "Helm"
+
+	// Metadata set by OLM on objects created for a ClusterServiceVersion
+	OLMOwnerLabel             = "olm.owner"
+	OLMOwnerKindLabel         = "olm.owner.kind"
+	OLMOwnerNamespaceLabel    = "olm.owner.namespace"
+	OLMManagedLabel           = "olm.managed"
+	OLMOperatorLabelPrefix    = "operators.coreos.com/"
+	ClusterServiceVersionKind = "ClusterServiceVersion"
+)
+
+const (
+	OLMMigrationPhaseWaitingForHealthyDeployment = "WaitingForHealthyDeployment"
+	OLMMigrationPhaseInProgress                  = "InProgress"
+	OLMMigrationPhaseFailed                      = "Failed"
+	OLMMigrationPhaseCompleted                   = "Completed"
+
+	// Migration steps in the order of execution
+	OLMMigrationStepAdoptInstance         = "AdoptInstance"
+	OLMMigrationStepDeleteSubscriptions   = "DeleteSubscriptions"
+	OLMMigrationStepDeleteCSVs            = "DeleteClusterServiceVersions"
+	OLMMigrationStepReleaseAdoptedObjects = "ReleaseAdoptedObjects"
+	OLMMigrationStepDeleteOLMOwnedObjects = "DeleteOLMOwnedObjects"
+)
+
+// OLMMigrationStatus is the progress of the migration stored in the status ConfigMap, so that it is resumed after restart
+type OLMMigrationStatus struct {
+	Phase   string `json:"phase"`
+	Message string `json:"message,omitempty"`
+	// Names of ClusterServiceVersions of the OLM-based deployment, kept to find objects owned by them after deletion
+	ClusterServiceVersions []string           `json:"clusterServiceVersions,omitempty"`
+	Steps                  []OLMMigrationStep `json:"steps,omitempty"`
+	StartTime              *metav1.Time       `json:"startTime,omitempty"`
+	CompletionTime         *metav1.Time       `json:"completionTime,omitempty"`
+}
+
+// OLMMigrationStep is a completed step of the migration with objects it changed, in kind/name format
+type OLMMigrationStep struct {
+	Name    string      `json:"name"`
+	Time    metav1.Time `json:"time"`
+	Objects []string    `json:"objects,omitempty"`
+}
+
+// IsStepCompleted checks if step with given name was already completed
+func (status *OLMMigrationStatus) IsStepCompleted(name string) bool {
+	return slices.ContainsFunc(status.Steps, func(step OLMMigrationStep) bool { return step.Name == name })
+}
+
+// CompleteStep records step with given name as completed, a step is recorded once
+func (status *OLMMigrationStatus) CompleteStep(name string, objects []string) {
+	if status.IsStepCompleted(name) {
+		return
+	}
+	status.Steps = append(status.Steps, OLMMigrationStep{Name: name, Time: metav1.Now(), Objects: objects})
+}
+
+// IsHelmManaged checks if object belongs to a Helm release
+func IsHelmManaged(obj metav1.Object) bool {
+	return obj.GetAnnotations()[HelmReleaseNameAnnotation] != ""
+}
+
+/*
+IsOwnedByCSV checks if object was created by OLM for one of given ClusterServiceVersions, either by owner reference
+or by OLM owner labels, which are also set on objects in other namespaces where owner references are not allowed.
+*/
+func IsOwnedByCSV(obj metav1.Object, csvNames []string) bool {
+	for _, ownerReference := range obj.GetOwnerReferences() {
+		if ownerReference.Kind == ClusterServiceVersionKind && slices.Contains(csvNames, ownerReference.Name) {
+			return true
+		}
+	}
+	objLabels := obj.GetLabels()
+	return objLabels[OLMOwnerKindLabel] == ClusterServiceVersionKind && slices.Contains(csvNames, objLabels[OLMOwnerLabel])
+}
+
+/*
+GetOwnerCSVName returns name of the ClusterServiceVersion from OLM owner labels of object, which are kept after the
+ClusterServiceVersion is deleted without its dependents. Returns empty string if object was not created for a CSV.
+*/
+func GetOwnerCSVName(obj metav1.Object) string {
+	objLabels := obj.GetLabels()
+	if objLabels[OLMOwnerKindLabel] != ClusterServiceVersionKind {
+		return ""
+	}
+	return objLabels[OLMOwnerLabel]
+}
+
+/*
+ReleaseFromOLM removes ClusterServiceVersion owner references and OLM labels from object, so that it is not garbage
+collected together with the ClusterServiceVersion. Returns true if object was changed.
+*/
+func ReleaseFromOLM(obj metav1.Object) bool {
+	changed := false
+	ownerReferences := slices.DeleteFunc(slices.Clone(obj.GetOwnerReferences()), func(ownerReference metav1.OwnerReference) bool {
+		return ownerReference.Kind == ClusterServiceVersionKind
+	})
+	if len(ownerReferences) != len(obj.GetOwnerReferences()) {
+		obj.SetOwnerReferences(ownerReferences)
+		changed = true
+	}
+	objLabels := obj.GetLabels()
+	for key := range objLabels {
+		if key == OLMOwnerLabel || key == OLMOwnerKindLabel || key == OLMOwnerNamespaceLabel || key == OLMManagedLabel ||
+			strings.HasPrefix(key, OLMOperatorLabelPrefix) {
+			delete(objLabels, key)
+			changed = true
+		}
+	}
+	if changed {
+		obj.SetLabels(objLabels)
+	}
+	return changed
+}
+
+/*
+AdoptByHelmRelease sets Helm release metadata of the owner on object and removes OLM labels from it, so that the object
+is managed by the same Helm release. Returns true if object was changed.
+*/
+func AdoptByHelmRelease(obj, owner metav1.Object) bool {
+	changed := ReleaseFromOLM(obj)
+	objAnnotations := obj.GetAnnotations()
+	if objAnnotations == nil {
+		objAnnotations = map[string]string{}
+	}
+	for _, key := range []string{HelmReleaseNameAnnotation, HelmReleaseNamespaceAnnotation} {
+		if value := owner.GetAnnotations()[key]; objAnnotations[key] != value {
+			objAnnotations[key] = value
+			changed = true
+		}
+	}
+	objLabels := obj.GetLabels()
+	if objLabels == nil {
+		objLabels = map[string]string{}
+	}
+	if objLabels[HelmManagedByLabel] != HelmManagedByValue {
+		objLabels[HelmManagedByLabel] = HelmManagedByValue
+		changed = true
+	}
+	obj.SetAnnotations(objAnnotations)
+	obj.SetLabels(objLabels)
+	return changed
+}
+
+// IsDeploymentRolledOut checks if all replicas of the current deployment generation are updated and available
+func IsDeploymentRolledOut(deployment *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas >= replicas &&
+		deployment.Status.AvailableReplicas >= replicas
+}
+
+// GetOLMMigrationStatus returns migration status from the status ConfigMap, or empty status if it does not exist
+func GetOLMMigrationStatus(ctx context.Context, reader c.Reader, namespace string) (OLMMigrationStatus, error) {
+	status := OLMMigrationStatus{}
+	configMap := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: OLMMigrationStatusConfigMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return status, nil
+		}
+		return status, err
+	}
+	err := yaml.Unmarshal([]byte(configMap.Data[OLMMigrationStatusConfigMapKey]), &status)
+	return status, err
+}
+
+// SaveOLMMigrationStatus writes migration status to the status ConfigMap in operator namespace, creating it if needed
+func SaveOLMMigrationStatus(ctx context.Context, client c.Client, reader c.Reader, namespace string, status OLMMigrationStatus) error {
+	data, err := yaml.Marshal(status)
+	if err != nil {
+		return err
+	}
+	configMap := &corev1.ConfigMap{}
+	err = reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: OLMMigrationStatusConfigMapName}, configMap)
+	if apierrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: OLMMigrationStatusConfigMapName, Namespace: namespace},
+			Data:       map[string]string{OLMMigrationStatusConfigMapKey: string(data)},
+		}
+		return client.Create(ctx, configMap)
+	} else if err != nil {
+		return err
+	}
+	if configMap.Data[OLMMigrationStatusConfigMapKey] == string(data) {
+		return nil
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[OLMMigrationStatusConfigMapKey] = string(data)
+	return client.Update(ctx, configMap)
+}
@@ -0,0 +1,159 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestOLMOwnership(t *testing.T) {
+	csvNames := []string{"ibm-licensing-operator.v4.2.0"}
+	olmLabels := map[string]string{
+		OLMOwnerLabel:          csvNames[0],
+		OLMOwnerKindLabel:      ClusterServiceVersionKind,
+		OLMOwnerNamespaceLabel: "ibm-licensing",
+		OLMOperatorLabelPrefix + OLMPackageName + ".ibm-licensing": "",
+		"app": "ibm-licensing",
+	}
+
+	t.Log("Given the need to find and release objects created by OLM")
+	{
+		byLabels := &metav1.ObjectMeta{Name: "role", Labels: olmLabels}
+		byOwner := &metav1.ObjectMeta{Name: "sa", OwnerReferences: []metav1.OwnerReference{{Kind: ClusterServiceVersionKind, Name: csvNames[0]}}}
+		otherCSV := &metav1.ObjectMeta{Name: "other", OwnerReferences: []metav1.OwnerReference{{Kind: ClusterServiceVersionKind, Name: "other.v1.0.0"}}}
+
+		if IsOwnedByCSV(byLabels, csvNames) && IsOwnedByCSV(byOwner, csvNames) {
+			t.Logf("\t%s\tShould find objects owned by CSV through labels and owner references", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould find objects owned by CSV through labels and owner references", FAIL)
+		}
+		if GetOwnerCSVName(byLabels) == csvNames[0] && GetOwnerCSVName(byOwner) == "" {
+			t.Logf("\t%s\tShould get CSV name from OLM owner labels", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould get CSV name from OLM owner labels", FAIL)
+		}
+		if !IsOwnedByCSV(otherCSV, csvNames) {
+			t.Logf("\t%s\tShould ignore objects owned by other CSVs", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould ignore objects owned by other CSVs", FAIL)
+		}
+
+		if ReleaseFromOLM(byLabels) && len(byLabels.Labels) == 1 && byLabels.Labels["app"] == "ibm-licensing" {
+			t.Logf("\t%s\tShould remove OLM labels and keep other ones", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould remove OLM labels and keep other ones, got %v", FAIL, byLabels.Labels)
+		}
+		if ReleaseFromOLM(byOwner) && len(byOwner.OwnerReferences) == 0 && !ReleaseFromOLM(byOwner) {
+			t.Logf("\t%s\tShould remove CSV owner references once", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould remove CSV owner references once", FAIL)
+		}
+	}
+}
+
+func TestAdoptByHelmRelease(t *testing.T) {
+	t.Log("Given the need to adopt IBMLicensing instance by Helm release of the operator")
+	{
+		owner := &metav1.ObjectMeta{Annotations: map[string]string{
+			HelmReleaseNameAnnotation:      "ibm-licensing",
+			HelmReleaseNamespaceAnnotation: "ibm-licensing",
+		}}
+		instance := &metav1.ObjectMeta{Name: "instance", Labels: map[string]string{
+			OLMOperatorLabelPrefix + OLMPackageName + ".ibm-licensing": "",
+		}}
+
+		if AdoptByHelmRelease(instance, owner) && IsHelmManaged(instance) &&
+			instance.Labels[HelmManagedByLabel] == HelmManagedByValue && len(instance.Labels) == 1 {
+			t.Logf("\t%s\tShould set Helm release metadata and remove OLM labels", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould set Helm release metadata and remove OLM labels, got %v %v", FAIL, instance.Labels, instance.Annotations)
+		}
+		if !AdoptByHelmRelease(instance, owner) {
+			t.Logf("\t%s\tShould not change already adopted instance", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould not change already adopted instance", FAIL)
+		}
+	}
+}
+
+func TestIsDeploymentRolledOut(t *testing.T) {
+	replicas := int32(2)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, UpdatedReplicas: 2, AvailableReplicas: 1},
+	}
+
+	t.Log("Given the need to verify that deployment is healthy")
+	{
+		if !IsDeploymentRolledOut(deployment) {
+			t.Logf("\t%s\tShould not be rolled out with unavailable replicas", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould not be rolled out with unavailable replicas", FAIL)
+		}
+		deployment.Status.AvailableReplicas = 2
+		if IsDeploymentRolledOut(deployment) {
+			t.Logf("\t%s\tShould be rolled out with all replicas updated and available", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould be rolled out with all replicas updated and available", FAIL)
+		}
+		deployment.Generation = 3
+		if !IsDeploymentRolledOut(deployment) {
+			t.Logf("\t%s\tShould not be rolled out before new generation is observed", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould not be rolled out before new generation is observed", FAIL)
+		}
+	}
+}
+
+func TestOLMMigrationStatus(t *testing.T) {
+	operatorNamespace := "ibm-licensing"
+	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+
+	t.Log("Given the need to resume the migration from OLM-based deployment")
+	{
+		status, err := GetOLMMigrationStatus(context.Background(), client, operatorNamespace)
+		if err == nil && status.Phase == "" {
+			t.Logf("\t%s\tShould return empty status before the migration", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould return empty status before the migration: %v", FAIL, err)
+		}
+
+		status.Phase = OLMMigrationPhaseInProgress
+		status.CompleteStep(OLMMigrationStepAdoptInstance, []string{"IBMLicensing/instance"})
+		status.CompleteStep(OLMMigrationStepAdoptInstance, nil)
+		for range 2 {
+			if err := SaveOLMMigrationStatus(context.Background(), client, client, operatorNamespace, status); err != nil {
+				t.Fatalf("\t%s\tShould save status: %v", FAIL, err)
+			}
+		}
+
+		saved, err := GetOLMMigrationStatus(context.Background(), client, operatorNamespace)
+		if err == nil && saved.Phase == OLMMigrationPhaseInProgress && len(saved.Steps) == 1 &&
+			saved.IsStepCompleted(OLMMigrationStepAdoptInstance) && !saved.IsStepCompleted(OLMMigrationStepDeleteCSVs) {
+			t.Logf("\t%s\tShould read saved status with completed steps", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould read saved status with completed steps, got %+v: %v", FAIL, saved, err)
+		}
+	}
+}
@@ -40,20 +40,34 @@ func DeleteBindInfoIfExists(ctx context.Context, reader client.Reader, writer cl
 	bindinfo := odlm.OperandBindInfo{}
 	retries := 3
 
+	// Waits before the next retry, returns false if ctx was cancelled in the meantime
+	wait := func() bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(retryTime):
+			return true
+		}
+	}
+
 	for retries > 0 {
 		err = reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: LsBindInfoName}, &bindinfo)
 		if err != nil {
 			if apierrors.IsNotFound(err) {
 				return nil
 			}
-			time.Sleep(retryTime)
+			if !wait() {
+				return ctx.Err()
+			}
 			retries = retries - 1
 			continue
 		}
 
 		err = writer.Delete(ctx, &bindinfo)
 		if err != nil {
-			time.Sleep(retryTime)
+			if !wait() {
+				return ctx.Err()
+			}
 			retries = retries - 1
 			continue
 		}
@@ -0,0 +1,332 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"reflect"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	c "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+const (
+	OperatorConfigAPIVersion = "operator.ibm.com/v1alpha1"
+	OperatorConfigKind       = "IBMLicensingOperatorConfig"
+	// Default name of the ConfigMap in operator namespace containing the operator configuration
+	OperatorConfigConfigMapName = "ibm-licensing-operator-config"
+	// Key of the ConfigMap data with the operator configuration in YAML format
+	OperatorConfigKey = "config.yaml"
+
+	// Self-signed certificates are issued for one year, so they must be renewed earlier
+	selfSignedCertificateValidity = 365 * 24 * time.Hour
+	// Jitter applied by client-go leader election to the retry period
+	leaderElectionJitterFactor = 1.2
+)
+
+// Image of License Service set in the operator deployment, restored when licensingImage is removed from the configuration
+var licensingImageFromEnv = os.Getenv(operatorv1alpha1.OperandLicensingImageEnvVar)
+
+/*
+OperatorConfig is the versioned configuration of the operator, read from the config.yaml key of the
+ibm-licensing-operator-config ConfigMap in operator namespace. Fields missing in the ConfigMap are set to defaults.
+
+Changes are applied without restarting the operator, except for the fields listed by GetRestartRequiredChanges.
+OPERATOR_NAMESPACE and WATCH_NAMESPACE are still read from the environment, as they are set by OLM or Helm.
+*/
+type OperatorConfig struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+
+	// Address the metric endpoint binds to
+	MetricsBindAddress string `json:"metricsBindAddress,omitempty"`
+	// Address the healthz and readyz endpoints bind to
+	HealthProbeBindAddress string `json:"healthProbeBindAddress,omitempty"`
+	// Ensures there is only one active operator replica, so that the operator can run with standby replicas
+	LeaderElection LeaderElectionConfig `json:"leaderElection,omitempty"`
+	// Selector of Secrets, Deployments and Pods cached by the operator
+	CacheLabelSelector string `json:"cacheLabelSelector,omitempty"`
+	// Image of License Service, overrides IBM_LICENSING_IMAGE environment variable of the operator
+	LicensingImage string `json:"licensingImage,omitempty"`
+	// Interval of rechecking cluster capabilities, which are not provided by CRDs, replaces CRD_RECONCILE_INTERVAL
+	CapabilitiesResyncInterval *metav1.Duration `json:"capabilitiesResyncInterval,omitempty"`
+	// Self-signed certificates expiring within this period are regenerated
+	CertificateRenewalWindow *metav1.Duration `json:"certificateRenewalWindow,omitempty"`
+	// Name of the ConfigMap in operator namespace with additional OperandRequest bindings under bindings.yaml key
+	BindingsConfigMap string `json:"bindingsConfigMap,omitempty"`
+	// Removes leftovers of the OLM-based deployment when the operator is deployed by Helm
+	MigrateFromOLM *bool `json:"migrateFromOLM,omitempty"`
+
+	DefaultInstance         DefaultInstanceConfig         `json:"defaultInstance,omitempty"`
+	OperandRequestDiscovery OperandRequestDiscoveryConfig `json:"operandRequestDiscovery,omitempty"`
+	OperatorGroup           OperatorGroupConfig           `json:"operatorGroup,omitempty"`
+}
+
+// LeaderElectionConfig configures election of the operator replica, which runs controllers and background tasks
+type LeaderElectionConfig struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// Time for which standby replicas wait before acquiring leadership, after the leader stopped renewing it
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+	// Time for which the leader tries to renew leadership before giving it up
+	RenewDeadline *metav1.Duration `json:"renewDeadline,omitempty"`
+	// Time between attempts of replicas to acquire or renew leadership
+	RetryPeriod *metav1.Duration `json:"retryPeriod,omitempty"`
+}
+
+// DefaultInstanceConfig configures the IBMLicensing instance created by the operator
+type DefaultInstanceConfig struct {
+	// Creates the default instance at startup and whenever all instances are deleted
+	Create *bool `json:"create,omitempty"`
+	// Name of the ConfigMap in operator namespace with the default instance template under instance.yaml key
+	TemplateConfigMap string `json:"templateConfigMap,omitempty"`
+	// Path to the YAML file with the default instance template, ignored if templateConfigMap is set
+	TemplateFile string `json:"templateFile,omitempty"`
+}
+
+// OperandRequestDiscoveryConfig configures discovering OperandRequests in namespaces outside the OperatorGroup
+type OperandRequestDiscoveryConfig struct {
+	// Time for which OperandRequest and Namespace events are collected before the OperatorGroup is updated
+	Debounce *metav1.Duration `json:"debounce,omitempty"`
+}
+
+// OperatorGroupConfig configures changes of the licensing OperatorGroup made by the operator
+type OperatorGroupConfig struct {
+	// Only records intended changes in the audit ConfigMap, without modifying the OperatorGroup
+	DryRun bool `json:"dryRun,omitempty"`
+	// Namespace patterns (f.e. team-*), which may be added to the OperatorGroup. All namespaces are allowed if empty.
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+	// Namespace patterns, which are never added to the OperatorGroup
+	DeniedNamespaces []string `json:"deniedNamespaces,omitempty"`
+	// Minimal time between updates of the OperatorGroup, as every update restarts the operator
+	UpdateInterval *metav1.Duration `json:"updateInterval,omitempty"`
+	// Interval of removing namespaces, which no longer exist, from the OperatorGroup
+	CleanupInterval *metav1.Duration `json:"cleanupInterval,omitempty"`
+}
+
+// DefaultOperatorConfig returns the configuration used when the operator config ConfigMap does not exist
+func DefaultOperatorConfig() *OperatorConfig {
+	config := &OperatorConfig{}
+	config.Default()
+	return config
+}
+
+// Default sets defaults of the fields missing in the configuration
+func (config *OperatorConfig) Default() {
+	if config.APIVersion == "" {
+		config.APIVersion = OperatorConfigAPIVersion
+	}
+	if config.Kind == "" {
+		config.Kind = OperatorConfigKind
+	}
+	if config.MetricsBindAddress == "" {
+		config.MetricsBindAddress = ":8080"
+	}
+	if config.HealthProbeBindAddress == "" {
+		config.HealthProbeBindAddress = ":8081"
+	}
+	if config.LeaderElection.Enabled == nil {
+		config.LeaderElection.Enabled = ptr.To(true)
+	}
+	if config.LeaderElection.LeaseDuration == nil {
+		config.LeaderElection.LeaseDuration = &metav1.Duration{Duration: 15 * time.Second}
+	}
+	if config.LeaderElection.RenewDeadline == nil {
+		config.LeaderElection.RenewDeadline = &metav1.Duration{Duration: 10 * time.Second}
+	}
+	if config.LeaderElection.RetryPeriod == nil {
+		config.LeaderElection.RetryPeriod = &metav1.Duration{Duration: 2 * time.Second}
+	}
+	if config.CacheLabelSelector == "" {
+		config.CacheLabelSelector = LicensingReleaseLabelKey + " in (" + LicensingReleaseLabelValue + ")"
+	}
+	if config.LicensingImage == "" {
+		config.LicensingImage = licensingImageFromEnv
+	}
+	if config.CapabilitiesResyncInterval == nil {
+		// Deprecated environment variable is still respected, invalid value was already reported at startup
+		resyncInterval, _ := GetCrdReconcileInterval()
+		config.CapabilitiesResyncInterval = &metav1.Duration{Duration: resyncInterval}
+	}
+	if config.CertificateRenewalWindow == nil {
+		config.CertificateRenewalWindow = &metav1.Duration{Duration: 90 * 24 * time.Hour}
+	}
+	if config.MigrateFromOLM == nil {
+		config.MigrateFromOLM = ptr.To(true)
+	}
+	if config.DefaultInstance.Create == nil {
+		config.DefaultInstance.Create = ptr.To(true)
+	}
+	if config.OperandRequestDiscovery.Debounce == nil {
+		config.OperandRequestDiscovery.Debounce = &metav1.Duration{Duration: 5 * time.Second}
+	}
+	if config.OperatorGroup.UpdateInterval == nil {
+		config.OperatorGroup.UpdateInterval = &metav1.Duration{Duration: time.Minute}
+	}
+	if config.OperatorGroup.CleanupInterval == nil {
+		config.OperatorGroup.CleanupInterval = &metav1.Duration{Duration: time.Hour}
+	}
+}
+
+// Validate checks the defaulted configuration
+func (config *OperatorConfig) Validate() error {
+	if config.APIVersion != OperatorConfigAPIVersion || config.Kind != OperatorConfigKind {
+		return fmt.Errorf("unsupported operator configuration %s %s, expected %s %s",
+			config.APIVersion, config.Kind, OperatorConfigAPIVersion, OperatorConfigKind)
+	}
+	if _, err := labels.Parse(config.CacheLabelSelector); err != nil {
+		return fmt.Errorf("invalid cacheLabelSelector: %w", err)
+	}
+	positiveDurations := map[string]*metav1.Duration{
+		"capabilitiesResyncInterval":       config.CapabilitiesResyncInterval,
+		"certificateRenewalWindow":         config.CertificateRenewalWindow,
+		"operandRequestDiscovery.debounce": config.OperandRequestDiscovery.Debounce,
+		"operatorGroup.cleanupInterval":    config.OperatorGroup.CleanupInterval,
+	}
+	for name, duration := range positiveDurations {
+		if duration.Duration <= 0 {
+			return fmt.Errorf("%s must be positive, got %s", name, duration.Duration)
+		}
+	}
+	if config.OperatorGroup.UpdateInterval.Duration < 0 {
+		return fmt.Errorf("operatorGroup.updateInterval must not be negative, got %s", config.OperatorGroup.UpdateInterval.Duration)
+	}
+	// Same constraints as checked by client-go leader election, reported here with the configuration field names
+	if config.LeaderElection.LeaseDuration.Duration <= config.LeaderElection.RenewDeadline.Duration {
+		return fmt.Errorf("leaderElection.leaseDuration must be greater than leaderElection.renewDeadline")
+	}
+	if float64(config.LeaderElection.RenewDeadline.Duration) <= leaderElectionJitterFactor*float64(config.LeaderElection.RetryPeriod.Duration) {
+		return fmt.Errorf("leaderElection.renewDeadline must be greater than %.1f times leaderElection.retryPeriod", leaderElectionJitterFactor)
+	}
+	if config.CertificateRenewalWindow.Duration >= selfSignedCertificateValidity {
+		return fmt.Errorf("certificateRenewalWindow must be shorter than validity of self-signed certificates (%s)", selfSignedCertificateValidity)
+	}
+	for _, pattern := range slices.Concat(config.OperatorGroup.AllowedNamespaces, config.OperatorGroup.DeniedNamespaces) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid OperatorGroup namespace pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// GetOperatorGroupPolicy returns restrictions of OperatorGroup changes from the configuration
+func (config *OperatorConfig) GetOperatorGroupPolicy() OperatorGroupPolicy {
+	return OperatorGroupPolicy{
+		DryRun:            config.OperatorGroup.DryRun,
+		AllowedNamespaces: config.OperatorGroup.AllowedNamespaces,
+		DeniedNamespaces:  config.OperatorGroup.DeniedNamespaces,
+	}
+}
+
+// ApplyLicensingImage sets the License Service image environment variable read when IBMLicensing defaults are filled
+func (config *OperatorConfig) ApplyLicensingImage() error {
+	if config.LicensingImage == "" {
+		return nil
+	}
+	return os.Setenv(operatorv1alpha1.OperandLicensingImageEnvVar, config.LicensingImage)
+}
+
+/*
+GetRestartRequiredChanges returns names of the changed fields, which are used only when the operator starts
+(manager options, cache configuration and OLM migration).
+*/
+func GetRestartRequiredChanges(previous, current *OperatorConfig) []string {
+	var changed []string
+	if previous.MetricsBindAddress != current.MetricsBindAddress {
+		changed = append(changed, "metricsBindAddress")
+	}
+	if previous.HealthProbeBindAddress != current.HealthProbeBindAddress {
+		changed = append(changed, "healthProbeBindAddress")
+	}
+	if !reflect.DeepEqual(previous.LeaderElection, current.LeaderElection) {
+		changed = append(changed, "leaderElection")
+	}
+	if previous.CacheLabelSelector != current.CacheLabelSelector {
+		changed = append(changed, "cacheLabelSelector")
+	}
+	if !reflect.DeepEqual(previous.MigrateFromOLM, current.MigrateFromOLM) {
+		changed = append(changed, "migrateFromOLM")
+	}
+	return changed
+}
+
+// ParseOperatorConfig parses the configuration in YAML format, applies overrides, sets defaults and validates it
+func ParseOperatorConfig(data []byte, overrides func(*OperatorConfig)) (*OperatorConfig, error) {
+	config := &OperatorConfig{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, err
+	}
+	if overrides != nil {
+		overrides(config)
+	}
+	config.Default()
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// GetOperatorConfig reads the configuration from the ConfigMap in operator namespace, defaults are used if it does not exist
+func GetOperatorConfig(ctx context.Context, reader c.Reader, namespace, name string, overrides func(*OperatorConfig)) (*OperatorConfig, error) {
+	configMap := corev1.ConfigMap{}
+	if err := reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	config, err := ParseOperatorConfig([]byte(configMap.Data[OperatorConfigKey]), overrides)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s in ConfigMap %s: %w", OperatorConfigKey, name, err)
+	}
+	return config, nil
+}
+
+// OperatorConfigStore holds the current configuration, which is replaced when the ConfigMap changes
+type OperatorConfigStore struct {
+	config atomic.Pointer[OperatorConfig]
+}
+
+func NewOperatorConfigStore(config *OperatorConfig) *OperatorConfigStore {
+	store := &OperatorConfigStore{}
+	store.Set(config)
+	return store
+}
+
+// Get returns the current configuration, or defaults if the store is not set. Returned configuration must not be modified.
+func (store *OperatorConfigStore) Get() *OperatorConfig {
+	if store == nil {
+		return DefaultOperatorConfig()
+	}
+	return store.config.Load()
+}
+
+func (store *OperatorConfigStore) Set(config *OperatorConfig) {
+	store.config.Store(config)
+}
@@ -0,0 +1,145 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestParseOperatorConfig(t *testing.T) {
+	t.Log("Given the need to read the operator configuration")
+	{
+		t.Log("\tTest 0:\tWhen the configuration is empty")
+		{
+			config, err := ParseOperatorConfig(nil, nil)
+			if err != nil {
+				t.Fatalf("\t%s\tShould parse empty configuration : %v", FAIL, err)
+			}
+			if *config.DefaultInstance.Create && *config.MigrateFromOLM && *config.LeaderElection.Enabled &&
+				config.CertificateRenewalWindow.Duration == 90*24*time.Hour &&
+				config.OperatorGroup.CleanupInterval.Duration == time.Hour &&
+				config.CacheLabelSelector == "release in (ibm-licensing-service)" {
+				t.Logf("\t%s\tShould use defaults", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould use defaults : %+v", FAIL, config)
+			}
+		}
+
+		t.Log("\tTest 1:\tWhen the configuration overrides defaults and flags are set")
+		{
+			data := []byte(`
+apiVersion: operator.ibm.com/v1alpha1
+kind: IBMLicensingOperatorConfig
+certificateRenewalWindow: 720h
+defaultInstance:
+  create: false
+operatorGroup:
+  deniedNamespaces: [kube-*]
+  updateInterval: 5m
+`)
+			config, err := ParseOperatorConfig(data, func(config *OperatorConfig) {
+				config.OperatorGroup.UpdateInterval = &metav1.Duration{Duration: 2 * time.Minute}
+			})
+			if err != nil {
+				t.Fatalf("\t%s\tShould parse configuration : %v", FAIL, err)
+			}
+			if !*config.DefaultInstance.Create && config.CertificateRenewalWindow.Duration == 720*time.Hour &&
+				!config.GetOperatorGroupPolicy().IsNamespaceAllowed("kube-system") {
+				t.Logf("\t%s\tShould use configured values", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould use configured values : %+v", FAIL, config)
+			}
+			if config.OperatorGroup.UpdateInterval.Duration == 2*time.Minute {
+				t.Logf("\t%s\tShould prefer overrides", SUCCESS)
+			} else {
+				t.Errorf("\t%s\tShould prefer overrides : %s", FAIL, config.OperatorGroup.UpdateInterval.Duration)
+			}
+		}
+
+		t.Log("\tTest 2:\tWhen the configuration is invalid")
+		{
+			invalid := map[string]string{
+				"unknown field":          "unknown: true",
+				"unsupported version":    "apiVersion: operator.ibm.com/v2",
+				"invalid label selector": "cacheLabelSelector: 'release in ('",
+				"negative interval":      "capabilitiesResyncInterval: -1s",
+				"too long renewal":       "certificateRenewalWindow: 9000h",
+				"short lease duration":   "leaderElection: {leaseDuration: 10s}",
+				"short renew deadline":   "leaderElection: {renewDeadline: 3s, retryPeriod: 3s}",
+				"invalid pattern":        "operatorGroup: {allowedNamespaces: ['team-[']}",
+			}
+			for name, data := range invalid {
+				if _, err := ParseOperatorConfig([]byte(data), nil); err != nil {
+					t.Logf("\t%s\tShould reject %s : %v", SUCCESS, name, err)
+				} else {
+					t.Errorf("\t%s\tShould reject %s", FAIL, name)
+				}
+			}
+		}
+	}
+}
+
+func TestGetOperatorConfig(t *testing.T) {
+	namespace := "ibm-licensing"
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: OperatorConfigConfigMapName, Namespace: namespace},
+		Data:       map[string]string{OperatorConfigKey: "bindingsConfigMap: custom-bindings"},
+	}
+
+	t.Log("Given the need to read the operator configuration from ConfigMap")
+	{
+		config, err := GetOperatorConfig(context.Background(), fake.NewClientBuilder().Build(), namespace, OperatorConfigConfigMapName, nil)
+		if err == nil && config.BindingsConfigMap == "" && *config.MigrateFromOLM {
+			t.Logf("\t%s\tShould use defaults when ConfigMap does not exist", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould use defaults when ConfigMap does not exist : %v", FAIL, err)
+		}
+
+		reader := fake.NewClientBuilder().WithObjects(configMap).Build()
+		config, err = GetOperatorConfig(context.Background(), reader, namespace, OperatorConfigConfigMapName, nil)
+		if err == nil && config.BindingsConfigMap == "custom-bindings" {
+			t.Logf("\t%s\tShould read configuration from ConfigMap", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould read configuration from ConfigMap : %v", FAIL, err)
+		}
+	}
+}
+
+func TestGetRestartRequiredChanges(t *testing.T) {
+	previous := DefaultOperatorConfig()
+	current := DefaultOperatorConfig()
+	current.LeaderElection.Enabled = ptr.To(false)
+	current.BindingsConfigMap = "custom-bindings"
+	current.OperatorGroup.DryRun = true
+
+	t.Log("Given the need to report changes of the operator configuration requiring restart")
+	{
+		changed := GetRestartRequiredChanges(previous, current)
+		if len(changed) == 1 && changed[0] == "leaderElection" {
+			t.Logf("\t%s\tShould report only fields used at startup", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould report only fields used at startup : %v", FAIL, changed)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package resources
+
+import (
+	"context"
+	"path"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	c "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigMap in operator namespace recording changes of the licensing OperatorGroup targetNamespaces
+	OperatorGroupAuditConfigMapName = "ibm-licensing-operatorgroup-audit"
+	OperatorGroupAuditConfigMapKey  = "changes.yaml"
+	// Number of the most recent changes kept in the audit ConfigMap
+	operatorGroupAuditLimit = 200
+
+	OperatorGroupNamespaceAdded   = "Add"
+	OperatorGroupNamespaceRemoved = "Remove"
+	OperatorGroupNamespaceDenied  = "Deny"
+)
+
+// OperatorGroupPolicy restricts changes of the licensing OperatorGroup made by the operator
+type OperatorGroupPolicy struct {
+	// Intended changes are only recorded in the audit ConfigMap, the OperatorGroup is not modified
+	DryRun bool
+	// Namespace patterns, which may be added to the OperatorGroup. All namespaces may be added if empty.
+	AllowedNamespaces []string
+	// Namespace patterns, which are never added to the OperatorGroup, takes precedence over AllowedNamespaces
+	DeniedNamespaces []string
+}
+
+// IsNamespaceAllowed checks if namespace may be added to the OperatorGroup. Patterns use path.Match syntax, f.e. "team-*".
+func (policy OperatorGroupPolicy) IsNamespaceAllowed(namespace string) bool {
+	matches := func(patterns []string) bool {
+		return slices.ContainsFunc(patterns, func(pattern string) bool {
+			matched, err := path.Match(pattern, namespace)
+			return err == nil && matched
+		})
+	}
+	if matches(policy.DeniedNamespaces) {
+		return false
+	}
+	return len(policy.AllowedNamespaces) == 0 || matches(policy.AllowedNamespaces)
+}
+
+// OperatorGroupChange is a single entry of the OperatorGroup audit trail
+type OperatorGroupChange struct {
+	Time      metav1.Time `json:"time"`
+	Action    string      `json:"action"`
+	Namespace string      `json:"namespace"`
+	Reason    string      `json:"reason"`
+	// OperandRequest which triggered the change, in namespace/name format
+	OperandRequest string `json:"operandRequest,omitempty"`
+	DryRun         bool   `json:"dryRun,omitempty"`
+}
+
+/*
+AppendOperatorGroupChanges returns the audit trail extended with the given changes, limited to the most recent entries.
+Dry-run and denied changes are repeated on every reconciliation, so they are skipped if they are the same as the last
+recorded change of the namespace.
+*/
+func AppendOperatorGroupChanges(recorded, changes []OperatorGroupChange) []OperatorGroupChange {
+	for _, change := range changes {
+		if change.DryRun || change.Action == OperatorGroupNamespaceDenied {
+			if last := lastOperatorGroupChange(recorded, change.Namespace); last != nil &&
+				last.Action == change.Action && last.DryRun == change.DryRun {
+				continue
+			}
+		}
+		recorded = append(recorded, change)
+	}
+	if len(recorded) > operatorGroupAuditLimit {
+		recorded = recorded[len(recorded)-operatorGroupAuditLimit:]
+	}
+	return recorded
+}
+
+func lastOperatorGroupChange(recorded []OperatorGroupChange, namespace string) *OperatorGroupChange {
+	for i := len(recorded) - 1; i >= 0; i-- {
+		if recorded[i].Namespace == namespace {
+			return &recorded[i]
+		}
+	}
+	return nil
+}
+
+// RecordOperatorGroupChanges appends changes to the audit ConfigMap in operator namespace, creating it if needed
+func RecordOperatorGroupChanges(ctx context.Context, client c.Client, reader c.Reader, namespace string, changes []OperatorGroupChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	configMap := &corev1.ConfigMap{}
+	err := reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: OperatorGroupAuditConfigMapName}, configMap)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	var recorded []OperatorGroupChange
+	if err := yaml.Unmarshal([]byte(configMap.Data[OperatorGroupAuditConfigMapKey]), &recorded); err != nil {
+		// corrupted audit trail is started again rather than blocking changes of the OperatorGroup
+		recorded = nil
+	}
+	data, err := yaml.Marshal(AppendOperatorGroupChanges(recorded, changes))
+	if err != nil {
+		return err
+	}
+
+	if configMap.ResourceVersion == "" {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: OperatorGroupAuditConfigMapName, Namespace: namespace},
+			Data:       map[string]string{OperatorGroupAuditConfigMapKey: string(data)},
+		}
+		return client.Create(ctx, configMap)
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[OperatorGroupAuditConfigMapKey] = string(data)
+	return client.Update(ctx, configMap)
+}
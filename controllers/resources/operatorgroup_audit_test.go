@@ -0,0 +1,79 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+)
+
+func TestOperatorGroupPolicy(t *testing.T) {
+	t.Log("Given the need to restrict namespaces added to IBMLicensing OperatorGroup")
+	{
+		policy := OperatorGroupPolicy{}
+		if policy.IsNamespaceAllowed("any") {
+			t.Logf("\t%s\tShould allow all namespaces without allow list", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould allow all namespaces without allow list", FAIL)
+		}
+
+		policy = OperatorGroupPolicy{AllowedNamespaces: []string{"team-*", "cp4i"}, DeniedNamespaces: []string{"team-secret"}}
+		for namespace, expected := range map[string]bool{"team-a": true, "cp4i": true, "team-secret": false, "other": false} {
+			if policy.IsNamespaceAllowed(namespace) == expected {
+				t.Logf("\t%s\tShould return %v for namespace %s", SUCCESS, expected, namespace)
+			} else {
+				t.Errorf("\t%s\tShould return %v for namespace %s", FAIL, expected, namespace)
+			}
+		}
+	}
+}
+
+func TestRecordOperatorGroupChanges(t *testing.T) {
+	operatorNamespace := "ibm-licensing"
+	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	dryRunChange := OperatorGroupChange{Action: OperatorGroupNamespaceAdded, Namespace: "ns1", DryRun: true, OperandRequest: "ns1/request"}
+	removal := OperatorGroupChange{Action: OperatorGroupNamespaceRemoved, Namespace: "ns2"}
+
+	t.Log("Given the need to record changes of IBMLicensing OperatorGroup")
+	{
+		for _, changes := range [][]OperatorGroupChange{{dryRunChange}, {dryRunChange, removal}, {removal}} {
+			if err := RecordOperatorGroupChanges(context.Background(), client, client, operatorNamespace, changes); err != nil {
+				t.Fatalf("\t%s\tShould record changes without an error: %v", FAIL, err)
+			}
+		}
+
+		configMap := corev1.ConfigMap{}
+		if err := client.Get(context.Background(), types.NamespacedName{Namespace: operatorNamespace, Name: OperatorGroupAuditConfigMapName}, &configMap); err != nil {
+			t.Fatalf("\t%s\tShould create audit ConfigMap: %v", FAIL, err)
+		}
+		var recorded []OperatorGroupChange
+		if err := yaml.Unmarshal([]byte(configMap.Data[OperatorGroupAuditConfigMapKey]), &recorded); err != nil {
+			t.Fatalf("\t%s\tShould record changes in YAML format: %v", FAIL, err)
+		}
+		if len(recorded) == 3 && recorded[0].OperandRequest == "ns1/request" {
+			t.Logf("\t%s\tShould record repeated dry-run change once and every removal", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould record repeated dry-run change once and every removal, recorded: %v", FAIL, recorded)
+		}
+	}
+}
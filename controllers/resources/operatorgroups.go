@@ -18,7 +18,9 @@ package resources
 
 import (
 	"context"
+	"slices"
 	"strings"
+	"time"
 
 	v1 "github.com/operator-framework/api/pkg/operators/v1"
 
@@ -27,6 +29,10 @@ import (
 
 const ibmLicensingPrefix = "IBMLicensing"
 
+// Annotation of the licensing OperatorGroup with the time of its last update by the operator, in RFC3339 format.
+// It is kept on the OperatorGroup, as the operator is restarted after every update of targetNamespaces.
+const OperatorGroupLastUpdateAnnotation = "operator.ibm.com/licensing-last-update"
+
 // Returns first found OperatorGroup with `ibm-licensing` in name, otherwise nil
 func GetLicensingOperatorGroupInNamespace(reader c.Reader, namespace string) (*v1.OperatorGroup, error) {
 
@@ -54,7 +60,33 @@ func GetLicensingOperatorGroupInNamespace(reader c.Reader, namespace string) (*v
 	return nil, nil
 }
 
-func ExtendOperatorGroupWithNamespaceList(namespaceList []string, operatorGroup *v1.OperatorGroup) *v1.OperatorGroup {
-	operatorGroup.Spec.TargetNamespaces = append(operatorGroup.Spec.TargetNamespaces, namespaceList...)
-	return operatorGroup
+/*
+ApplyOperatorGroupChanges returns targetNamespaces with the additions and removals applied, together with the changes,
+which actually modify the list. Additions of namespaces already present and removals of absent ones are skipped.
+*/
+func ApplyOperatorGroupChanges(targetNamespaces []string, changes []OperatorGroupChange) ([]string, []OperatorGroupChange) {
+	updated := slices.Clone(targetNamespaces)
+	var applied []OperatorGroupChange
+	for _, change := range changes {
+		present := slices.Contains(updated, change.Namespace)
+		switch {
+		case change.Action == OperatorGroupNamespaceAdded && !present:
+			updated = append(updated, change.Namespace)
+		case change.Action == OperatorGroupNamespaceRemoved && present:
+			updated = slices.DeleteFunc(updated, func(namespace string) bool { return namespace == change.Namespace })
+		default:
+			continue
+		}
+		applied = append(applied, change)
+	}
+	return updated, applied
+}
+
+// GetOperatorGroupLastUpdate returns time of the last update of the OperatorGroup by the operator, zero if unknown
+func GetOperatorGroupLastUpdate(operatorGroup *v1.OperatorGroup) time.Time {
+	lastUpdate, err := time.Parse(time.RFC3339, operatorGroup.Annotations[OperatorGroupLastUpdateAnnotation])
+	if err != nil {
+		return time.Time{}
+	}
+	return lastUpdate
 }
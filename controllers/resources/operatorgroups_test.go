@@ -17,7 +17,9 @@
 package resources
 
 import (
+	"slices"
 	"testing"
+	"time"
 
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -74,3 +76,54 @@ func TestGetLicensingOperatorGroupInNamespace(t *testing.T) {
 
 	}
 }
+
+func TestApplyOperatorGroupChanges(t *testing.T) {
+	t.Log("Given the need to apply batched changes to IBMLicensing OperatorGroup targetNamespaces")
+	{
+		targetNamespaces := []string{"ibm-licensing", "ns1", "ns2"}
+		changes := []OperatorGroupChange{
+			{Action: OperatorGroupNamespaceAdded, Namespace: "ns3"},
+			{Action: OperatorGroupNamespaceAdded, Namespace: "ns1"},
+			{Action: OperatorGroupNamespaceRemoved, Namespace: "ns2"},
+			{Action: OperatorGroupNamespaceRemoved, Namespace: "ns4"},
+		}
+
+		updated, applied := ApplyOperatorGroupChanges(targetNamespaces, changes)
+		if slices.Equal(updated, []string{"ibm-licensing", "ns1", "ns3"}) {
+			t.Logf("\t%s\tShould add missing and remove present namespaces", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould add missing and remove present namespaces, got %v", FAIL, updated)
+		}
+		if len(applied) == 2 && applied[0].Namespace == "ns3" && applied[1].Namespace == "ns2" {
+			t.Logf("\t%s\tShould return only changes modifying targetNamespaces", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould return only changes modifying targetNamespaces, got %v", FAIL, applied)
+		}
+		if slices.Equal(targetNamespaces, []string{"ibm-licensing", "ns1", "ns2"}) {
+			t.Logf("\t%s\tShould not modify given targetNamespaces", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould not modify given targetNamespaces, got %v", FAIL, targetNamespaces)
+		}
+	}
+}
+
+func TestGetOperatorGroupLastUpdate(t *testing.T) {
+	t.Log("Given the need to read time of the last OperatorGroup update")
+	{
+		lastUpdate := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		operatorGroup := OperatorGroupObj("ibm-licensing-og", "ibm-licensing",
+			map[string]string{OperatorGroupLastUpdateAnnotation: lastUpdate.Format(time.RFC3339)}, nil)
+		if GetOperatorGroupLastUpdate(&operatorGroup).Equal(lastUpdate) {
+			t.Logf("\t%s\tShould parse the annotation", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould parse the annotation", FAIL)
+		}
+
+		operatorGroup.Annotations = nil
+		if GetOperatorGroupLastUpdate(&operatorGroup).IsZero() {
+			t.Logf("\t%s\tShould return zero time without the annotation", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould return zero time without the annotation", FAIL)
+		}
+	}
+}
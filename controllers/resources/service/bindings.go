@@ -0,0 +1,176 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/api/v1alpha1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/IBM/ibm-licensing-operator/controllers/resources"
+)
+
+const (
+	// Label set on Secrets and ConfigMaps copied to OperandRequest namespace, with the binding key as value
+	BindingKeyLabel = "operator.ibm.com/licensing-binding"
+	// Annotation set on copies with the revision of the source data, so that consumers can detect freshness
+	SourceRevisionAnnotation = "operator.ibm.com/licensing-source-revision"
+	// Key of the ConfigMap data with additional bindings in YAML format
+	BindingsConfigMapKey = "bindings.yaml"
+
+	SecretKind    = "Secret"
+	ConfigMapKind = "ConfigMap"
+)
+
+// Binding describes Secret and ConfigMap from operator namespace, which are shared under the binding key
+// with namespace of OperandRequest requesting ibm-licensing-operator
+type Binding struct {
+	Key string `json:"key"`
+	// Name of the Secret in operator namespace, copied under the name from the secret field of OperandRequest binding
+	Secret string `json:"secret,omitempty"`
+	// Name of the ConfigMap in operator namespace, copied under the name from the configmap field of OperandRequest binding
+	ConfigMap string `json:"configmap,omitempty"`
+	// Removes the binding with the same key from defaults
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+// BindingCopy is a single Secret or ConfigMap, which should be copied to OperandRequest namespace
+type BindingCopy struct {
+	Key    string
+	Kind   string
+	Source string
+	Target string
+}
+
+// DefaultBindings returns bindings shared by License Service with other components
+func DefaultBindings() []Binding {
+	return []Binding{
+		{Key: "public-api-data", Secret: LicensingToken, ConfigMap: LicensingInfo},
+		{Key: "public-api-token", Secret: LicensingToken},
+		{Key: "public-api-upload", Secret: LicensingUploadToken, ConfigMap: LicensingUploadConfig},
+	}
+}
+
+/*
+MergeBindings parses bindings in YAML format and merges them with the defaults.
+A binding with a key existing in defaults replaces it, or removes it if disabled. Result is sorted by key.
+*/
+func MergeBindings(defaults []Binding, configured []byte) ([]Binding, error) {
+	var additional []Binding
+	if err := yaml.UnmarshalStrict(configured, &additional); err != nil {
+		return nil, err
+	}
+
+	bindingsByKey := map[string]Binding{}
+	for _, binding := range defaults {
+		bindingsByKey[binding.Key] = binding
+	}
+	for _, binding := range additional {
+		if binding.Key == "" {
+			return nil, fmt.Errorf("binding key must not be empty")
+		}
+		if binding.Disabled {
+			delete(bindingsByKey, binding.Key)
+			continue
+		}
+		if binding.Secret == "" && binding.ConfigMap == "" {
+			return nil, fmt.Errorf("binding %s must have secret or configmap", binding.Key)
+		}
+		bindingsByKey[binding.Key] = binding
+	}
+
+	merged := make([]Binding, 0, len(bindingsByKey))
+	for _, binding := range bindingsByKey {
+		merged = append(merged, binding)
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Key < merged[j].Key })
+	return merged, nil
+}
+
+/*
+GetBindingCopies returns Secrets and ConfigMaps, which should be copied for bindings requested in OperandRequest.
+If OperandRequest does not specify any bindings, all bindings are copied under default names.
+Copies with the same kind and target name are returned once.
+*/
+func GetBindingCopies(bindings []Binding, requested map[string]odlm.SecretConfigmap) []BindingCopy {
+	var copies []BindingCopy
+	added := map[string]bool{}
+	add := func(key, kind, source, target string) {
+		if source == "" {
+			return
+		}
+		if target == "" {
+			target = resources.LsBindInfoName + "-" + source
+		}
+		if added[kind+"/"+target] {
+			return
+		}
+		added[kind+"/"+target] = true
+		copies = append(copies, BindingCopy{Key: key, Kind: kind, Source: source, Target: target})
+	}
+
+	for _, binding := range bindings {
+		requestedBinding, isRequested := requested[binding.Key]
+		if len(requested) > 0 && !isRequested {
+			continue
+		}
+		add(binding.Key, SecretKind, binding.Secret, requestedBinding.Secret)
+		add(binding.Key, ConfigMapKind, binding.ConfigMap, requestedBinding.Configmap)
+	}
+	return copies
+}
+
+// GetUnknownBindingKeys returns sorted keys of requested bindings, which are not configured
+func GetUnknownBindingKeys(bindings []Binding, requested map[string]odlm.SecretConfigmap) []string {
+	known := map[string]bool{}
+	for _, binding := range bindings {
+		known[binding.Key] = true
+	}
+	var unknown []string
+	for _, key := range slices.Sorted(maps.Keys(requested)) {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	return unknown
+}
+
+// GetBindingConditionType returns type of OperandRequest condition reporting the state of binding with given key
+func GetBindingConditionType(key string) odlm.ConditionType {
+	return odlm.ConditionType(resources.OperatorName + "/" + key)
+}
+
+/*
+GetSourceRevision returns revision of Secret or ConfigMap data, computed as a hash of the binary and string data.
+The revision changes only when the content changes, independently of the object's resourceVersion.
+*/
+func GetSourceRevision(binaryData map[string][]byte, stringData map[string]string) string {
+	hash := sha256.New()
+	for _, key := range slices.Sorted(maps.Keys(binaryData)) {
+		fmt.Fprintf(hash, "b:%s=%x;", key, binaryData[key])
+	}
+	for _, key := range slices.Sorted(maps.Keys(stringData)) {
+		fmt.Fprintf(hash, "s:%s=%x;", key, stringData[key])
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
@@ -0,0 +1,92 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"testing"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeBindings(t *testing.T) {
+	configured := []byte(`
+- key: public-api-token
+  disabled: true
+- key: public-api-ca
+  configmap: ibm-licensing-ca-bundle
+- key: public-api-upload
+  secret: custom-upload-token
+`)
+	merged, err := MergeBindings(DefaultBindings(), configured)
+	assert.NoError(t, err)
+	assert.Equal(t, []Binding{
+		{Key: "public-api-ca", ConfigMap: "ibm-licensing-ca-bundle"},
+		{Key: "public-api-data", Secret: LicensingToken, ConfigMap: LicensingInfo},
+		{Key: "public-api-upload", Secret: "custom-upload-token"},
+	}, merged)
+
+	_, err = MergeBindings(DefaultBindings(), []byte(`[{key: empty}]`))
+	assert.Error(t, err, "Binding without secret and configmap should be rejected")
+
+	_, err = MergeBindings(DefaultBindings(), []byte(`[{key: a, secret: b, unknown: c}]`))
+	assert.Error(t, err, "Unknown fields should be rejected")
+}
+
+func TestGetBindingCopies(t *testing.T) {
+	bindings := DefaultBindings()
+
+	copies := GetBindingCopies(bindings, map[string]odlm.SecretConfigmap{
+		"public-api-data":  {Secret: "secret1", Configmap: "cm1"},
+		"public-api-token": {Secret: "secret1"},
+	})
+	assert.Equal(t, []BindingCopy{
+		{Key: "public-api-data", Kind: SecretKind, Source: LicensingToken, Target: "secret1"},
+		{Key: "public-api-data", Kind: ConfigMapKind, Source: LicensingInfo, Target: "cm1"},
+	}, copies, "Only requested bindings should be copied, each target once")
+
+	copies = GetBindingCopies(bindings, nil)
+	assert.Equal(t, []BindingCopy{
+		{Key: "public-api-data", Kind: SecretKind, Source: LicensingToken, Target: "ibm-licensing-bindinfo-" + LicensingToken},
+		{Key: "public-api-data", Kind: ConfigMapKind, Source: LicensingInfo, Target: "ibm-licensing-bindinfo-" + LicensingInfo},
+		{Key: "public-api-upload", Kind: SecretKind, Source: LicensingUploadToken, Target: "ibm-licensing-bindinfo-" + LicensingUploadToken},
+		{Key: "public-api-upload", Kind: ConfigMapKind, Source: LicensingUploadConfig, Target: "ibm-licensing-bindinfo-" + LicensingUploadConfig},
+	}, copies, "All bindings should be copied under default names when none are requested")
+}
+
+func TestGetUnknownBindingKeys(t *testing.T) {
+	bindings := DefaultBindings()
+
+	assert.Empty(t, GetUnknownBindingKeys(bindings, nil))
+	assert.Empty(t, GetUnknownBindingKeys(bindings, map[string]odlm.SecretConfigmap{"public-api-data": {}}))
+	assert.Equal(t, []string{"custom", "other"}, GetUnknownBindingKeys(bindings, map[string]odlm.SecretConfigmap{
+		"other":            {},
+		"public-api-token": {},
+		"custom":           {},
+	}), "Keys missing in bindings should be returned sorted")
+}
+
+func TestGetSourceRevision(t *testing.T) {
+	revision := GetSourceRevision(map[string][]byte{"token": []byte("a"), "ca.crt": []byte("b")}, nil)
+	assert.Len(t, revision, 16)
+	assert.Equal(t, revision, GetSourceRevision(map[string][]byte{"ca.crt": []byte("b"), "token": []byte("a")}, nil),
+		"Revision should not depend on the order of keys")
+	assert.NotEqual(t, revision, GetSourceRevision(map[string][]byte{"token": []byte("c"), "ca.crt": []byte("b")}, nil),
+		"Revision should change when data changes")
+	assert.NotEqual(t, GetSourceRevision(map[string][]byte{"key": []byte("a")}, nil), GetSourceRevision(nil, map[string]string{"key": "a"}),
+		"Binary and string data should be distinguished")
+}
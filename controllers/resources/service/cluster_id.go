@@ -0,0 +1,65 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+// ConfigMap in instance namespace persisting cluster ID generated by the operator
+const ClusterIDConfigMapName = "ibm-licensing-cluster-id"
+
+const (
+	ClusterIDConfigMapKey       = "clusterID"
+	ClusterIDSourceConfigMapKey = "source"
+
+	// Sources of generated cluster ID
+	ClusterVersionClusterIDSource = "ClusterVersion"
+	KubeSystemClusterIDSource     = "kube-system"
+)
+
+/*
+GetClusterIDConfigMap returns ConfigMap persisting generated cluster ID. It is not controlled by the instance,
+so that the ID survives recreation of IBMLicensing.
+*/
+func GetClusterIDConfigMap(instance *operatorv1alpha1.IBMLicensing, clusterID, source string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ClusterIDConfigMapName,
+			Namespace: instance.Spec.InstanceNamespace,
+			Labels:    LabelsForMeta(instance),
+		},
+		Data: map[string]string{
+			ClusterIDConfigMapKey:       clusterID,
+			ClusterIDSourceConfigMapKey: source,
+		},
+	}
+}
+
+// GetEffectiveClusterID returns cluster ID set in spec, or the generated one persisted in the ConfigMap
+func GetEffectiveClusterID(spec operatorv1alpha1.IBMLicensingSpec, clusterIDConfigMap *corev1.ConfigMap) string {
+	if spec.Sender != nil && spec.Sender.ClusterID != "" {
+		return spec.Sender.ClusterID
+	}
+	if clusterIDConfigMap == nil {
+		return ""
+	}
+	return clusterIDConfigMap.Data[ClusterIDConfigMapKey]
+}
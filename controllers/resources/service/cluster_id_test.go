@@ -0,0 +1,40 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+func TestGetEffectiveClusterID(t *testing.T) {
+	instance := &operatorv1alpha1.IBMLicensing{}
+	instance.Spec.InstanceNamespace = "namespace"
+	instance.Spec.Sender = &operatorv1alpha1.IBMLicensingSenderSpec{}
+	configMap := GetClusterIDConfigMap(instance, "generated-id", KubeSystemClusterIDSource)
+
+	assert.Equal(t, "namespace", configMap.Namespace)
+	assert.Empty(t, configMap.OwnerReferences, "Cluster ID should survive deletion of the instance")
+	assert.Equal(t, "", GetEffectiveClusterID(instance.Spec, nil))
+	assert.Equal(t, "generated-id", GetEffectiveClusterID(instance.Spec, configMap), "Generated ID should be used when clusterID is not set")
+
+	instance.Spec.Sender.ClusterID = "configured-id"
+	assert.Equal(t, "configured-id", GetEffectiveClusterID(instance.Spec, configMap), "Configured clusterID should take precedence")
+}
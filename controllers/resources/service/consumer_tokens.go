@@ -0,0 +1,119 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+// Secret in instance namespace holding API tokens of consumer namespaces, under keys equal to namespace names
+const ConsumerTokensSecretName = "ibm-licensing-consumer-tokens"
+
+// Annotation of consumer tokens secret with JSON map of namespaces to RFC3339 time of issuing their tokens
+const ConsumerTokensIssuedAnnotation = "operator.ibm.com/licensing-tokens-issued"
+
+func GetConsumerTokensSecret(instance *operatorv1alpha1.IBMLicensing) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ConsumerTokensSecretName,
+			Namespace:   instance.Spec.InstanceNamespace,
+			Labels:      LabelsForMeta(instance),
+			Annotations: instance.Spec.Annotations,
+		},
+		Type: corev1.SecretTypeOpaque,
+	}
+}
+
+/*
+IssueConsumerToken returns API token of the namespace from consumer tokens secret.
+If the namespace has no token yet, a new one is generated and stored in the secret, in which case changed is true.
+*/
+func IssueConsumerToken(secret *corev1.Secret, namespace string, now time.Time) (token string, changed bool, err error) {
+	if existing, found := secret.Data[namespace]; found && len(existing) > 0 {
+		return string(existing), false, nil
+	}
+	token, err = randString(24)
+	if err != nil {
+		return "", false, err
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[namespace] = []byte(token)
+
+	issued := getConsumerTokensIssueTimes(secret)
+	issued[namespace] = now.UTC().Format(time.RFC3339)
+	setConsumerTokensIssueTimes(secret, issued)
+	return token, true, nil
+}
+
+// RevokeConsumerTokens removes tokens of namespaces not present in keep and returns sorted revoked namespaces
+func RevokeConsumerTokens(secret *corev1.Secret, keep map[string]bool) []string {
+	var revoked []string
+	issued := getConsumerTokensIssueTimes(secret)
+	for _, namespace := range slices.Sorted(maps.Keys(secret.Data)) {
+		if !keep[namespace] {
+			delete(secret.Data, namespace)
+			delete(issued, namespace)
+			revoked = append(revoked, namespace)
+		}
+	}
+	if len(revoked) > 0 {
+		setConsumerTokensIssueTimes(secret, issued)
+	}
+	return revoked
+}
+
+// GetIssuedAPITokens returns tokens stored in consumer tokens secret for IBMLicensing status, sorted by namespace
+func GetIssuedAPITokens(secret *corev1.Secret) []operatorv1alpha1.IssuedAPIToken {
+	issued := getConsumerTokensIssueTimes(secret)
+	var tokens []operatorv1alpha1.IssuedAPIToken
+	for _, namespace := range slices.Sorted(maps.Keys(secret.Data)) {
+		issuedToken := operatorv1alpha1.IssuedAPIToken{Namespace: namespace, CreationTime: secret.CreationTimestamp}
+		if creationTime, err := time.Parse(time.RFC3339, issued[namespace]); err == nil {
+			issuedToken.CreationTime = metav1.NewTime(creationTime)
+		}
+		tokens = append(tokens, issuedToken)
+	}
+	return tokens
+}
+
+func getConsumerTokensIssueTimes(secret *corev1.Secret) map[string]string {
+	issued := map[string]string{}
+	if value, found := secret.Annotations[ConsumerTokensIssuedAnnotation]; found {
+		// Malformed annotation only loses issue times, tokens themselves stay valid
+		_ = json.Unmarshal([]byte(value), &issued)
+	}
+	return issued
+}
+
+func setConsumerTokensIssueTimes(secret *corev1.Secret, issued map[string]string) {
+	// Marshalling map of strings cannot fail
+	value, _ := json.Marshal(issued)
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[ConsumerTokensIssuedAnnotation] = string(value)
+}
@@ -0,0 +1,58 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestIssueAndRevokeConsumerTokens(t *testing.T) {
+	secret := &corev1.Secret{}
+	issueTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tokenA, changed, err := IssueConsumerToken(secret, "namespace-a", issueTime)
+	assert.NoError(t, err)
+	assert.True(t, changed, "New token should be stored in the secret")
+	assert.Len(t, tokenA, 24)
+
+	again, changed, err := IssueConsumerToken(secret, "namespace-a", issueTime.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.False(t, changed, "Existing token should be reused")
+	assert.Equal(t, tokenA, again)
+
+	tokenB, _, err := IssueConsumerToken(secret, "namespace-b", issueTime.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.NotEqual(t, tokenA, tokenB, "Every namespace should get a distinct token")
+
+	issued := GetIssuedAPITokens(secret)
+	assert.Len(t, issued, 2)
+	assert.Equal(t, "namespace-a", issued[0].Namespace)
+	assert.True(t, issueTime.Equal(issued[0].CreationTime.Time), "Issue time should be kept in status")
+	assert.Equal(t, "namespace-b", issued[1].Namespace)
+
+	revoked := RevokeConsumerTokens(secret, map[string]bool{"namespace-b": true})
+	assert.Equal(t, []string{"namespace-a"}, revoked)
+	assert.NotContains(t, secret.Data, "namespace-a")
+	assert.Equal(t, tokenB, string(secret.Data["namespace-b"]))
+	assert.NotContains(t, secret.Annotations[ConsumerTokensIssuedAnnotation], "namespace-a")
+
+	assert.Empty(t, RevokeConsumerTokens(secret, map[string]bool{"namespace-b": true}), "Nothing should be revoked twice")
+}
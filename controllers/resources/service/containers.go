@@ -33,7 +33,14 @@ const (
 	softwareCentralDefaultFrequency = "5 0 * * *"
 )
 
-func getLicensingEnvironmentVariables(spec operatorv1alpha1.IBMLicensingSpec) []corev1.EnvVar {
+// returns variables computed by the operator merged with spec.envVariable, sorted by name
+func getLicensingEnvironmentVariables(spec operatorv1alpha1.IBMLicensingSpec, capabilities resources.Capabilities) []corev1.EnvVar {
+	environmentVariables, _ := MergeOperandEnvVariables(getOperatorEnvironmentVariables(spec, capabilities), spec.EnvVariable)
+	return environmentVariables
+}
+
+// returns variables computed by the operator from the spec, every variable has to be registered in operandSettings
+func getOperatorEnvironmentVariables(spec operatorv1alpha1.IBMLicensingSpec, capabilities resources.Capabilities) []corev1.EnvVar {
 	var httpsEnableString = strconv.FormatBool(spec.HTTPSEnable)
 	var environmentVariables = []corev1.EnvVar{
 		{
@@ -107,7 +114,17 @@ func getLicensingEnvironmentVariables(spec operatorv1alpha1.IBMLicensingSpec) []
 			Name:  "NAMESPACE_SCOPE_ENABLED",
 			Value: "true",
 		})
-		if spec.IsCustomNamespaceScopeConfigMap() {
+		if spec.IsNamespaceScopeSelector() {
+			environmentVariables = append(environmentVariables, corev1.EnvVar{
+				Name: "WATCH_NAMESPACE",
+				ValueFrom: &corev1.EnvVarSource{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						Key:                  NamespaceScopeConfigMapKey,
+						LocalObjectReference: corev1.LocalObjectReference{Name: NamespaceScopeConfigMapName},
+					},
+				},
+			})
+		} else if spec.IsCustomNamespaceScopeConfigMap() {
 			customNsConfigMapName := spec.GetCustomNamespaceScopeConfigMap()
 			environmentVariables = append(environmentVariables, corev1.EnvVar{
 				Name: "WATCH_NAMESPACE",
@@ -146,7 +163,7 @@ func getLicensingEnvironmentVariables(spec operatorv1alpha1.IBMLicensingSpec) []
 			Value: "false",
 		})
 	}
-	if spec.IsPrometheusQuerySourceEnabled() && resources.IsServiceCAAPI {
+	if spec.IsPrometheusQuerySourceEnabled() && capabilities.ServiceCAAPI {
 		environmentVariables = append(environmentVariables, corev1.EnvVar{
 			Name:  "PROMETHEUS_QUERY_SOURCE_ENABLED",
 			Value: "true",
@@ -225,14 +242,9 @@ func getLicensingEnvironmentVariables(spec operatorv1alpha1.IBMLicensingSpec) []
 		}...)
 	}
 
-	if spec.EnvVariable != nil {
-		for key, value := range spec.EnvVariable {
-			environmentVariables = append(environmentVariables, corev1.EnvVar{
-				Name:  key,
-				Value: value,
-			})
-		}
-	}
+	// Proxy and trusted certificates for outbound connections
+	environmentVariables = append(environmentVariables, getOutboundNetworkEnvironmentVariables(spec.OutboundNetwork)...)
+
 	return environmentVariables
 }
 
@@ -269,10 +281,10 @@ echo "$(date): All required secrets exist"
 	return script
 }
 
-func GetLicensingInitContainers(spec operatorv1alpha1.IBMLicensingSpec) []corev1.Container {
+func GetLicensingInitContainers(spec operatorv1alpha1.IBMLicensingSpec, capabilities resources.Capabilities) []corev1.Container {
 	containers := []corev1.Container{}
 	if spec.IsMetering() {
-		baseContainer := getLicensingContainerBase(spec)
+		baseContainer := getLicensingContainerBase(spec, capabilities)
 		meteringSecretCheckContainer := corev1.Container{}
 		baseContainer.DeepCopyInto(&meteringSecretCheckContainer)
 		meteringSecretCheckContainer.Name = "metering-check-secret"
@@ -283,8 +295,8 @@ func GetLicensingInitContainers(spec operatorv1alpha1.IBMLicensingSpec) []corev1
 		}
 		containers = append(containers, meteringSecretCheckContainer)
 	}
-	if resources.IsServiceCAAPI && spec.HTTPSEnable && spec.HTTPSCertsSource == operatorv1alpha1.OcpCertsSource {
-		baseContainer := getLicensingContainerBase(spec)
+	if capabilities.ServiceCAAPI && spec.HTTPSEnable && spec.HTTPSCertsSource == operatorv1alpha1.OcpCertsSource {
+		baseContainer := getLicensingContainerBase(spec, capabilities)
 		ocpSecretCheckContainer := corev1.Container{}
 
 		baseContainer.DeepCopyInto(&ocpSecretCheckContainer)
@@ -297,7 +309,7 @@ func GetLicensingInitContainers(spec operatorv1alpha1.IBMLicensingSpec) []corev1
 		containers = append(containers, ocpSecretCheckContainer)
 
 		if spec.IsPrometheusServiceNeeded() {
-			baseContainer := getLicensingContainerBase(spec)
+			baseContainer := getLicensingContainerBase(spec, capabilities)
 			ocpPrometheusSecretCheckContainer := corev1.Container{}
 
 			baseContainer.DeepCopyInto(&ocpPrometheusSecretCheckContainer)
@@ -313,13 +325,13 @@ func GetLicensingInitContainers(spec operatorv1alpha1.IBMLicensingSpec) []corev1
 	return containers
 }
 
-func getLicensingContainerBase(spec operatorv1alpha1.IBMLicensingSpec) corev1.Container {
+func getLicensingContainerBase(spec operatorv1alpha1.IBMLicensingSpec, capabilities resources.Capabilities) corev1.Container {
 	container := resources.GetContainerBase(spec.Container)
 	if spec.SecurityContext != nil {
 		container.SecurityContext.RunAsUser = &spec.SecurityContext.RunAsUser
 	}
 	container.VolumeMounts = getLicensingVolumeMounts(spec)
-	container.Env = getLicensingEnvironmentVariables(spec)
+	container.Env = getLicensingEnvironmentVariables(spec, capabilities)
 	container.Ports = getLicensingContainerPorts(spec)
 	return container
 }
@@ -370,10 +382,10 @@ func getSoftwareCentralFrequency(swc *operatorv1alpha1.IBMLicensingSoftwareCentr
 	return frequency
 }
 
-func GetLicensingContainer(spec operatorv1alpha1.IBMLicensingSpec) []corev1.Container {
+func GetLicensingContainer(spec operatorv1alpha1.IBMLicensingSpec, capabilities resources.Capabilities) []corev1.Container {
 	var containers []corev1.Container
 
-	licensingContainer := getLicensingContainerBase(spec)
+	licensingContainer := getLicensingContainerBase(spec, capabilities)
 	probeHandler := getProbeHandler(spec)
 	licensingContainer.Name = "license-service"
 	licensingContainer.LivenessProbe = resources.GetLivenessProbe(probeHandler)
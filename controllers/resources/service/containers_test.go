@@ -24,6 +24,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources"
 )
 
 func TestGetLicensingEnvironmentVariablesCertsValidationDisabledWithCerts(t *testing.T) {
@@ -41,7 +42,7 @@ func TestGetLicensingEnvironmentVariablesCertsValidationDisabledWithCerts(t *tes
 		Value: "true",
 	}
 
-	envVars := getLicensingEnvironmentVariables(spec)
+	envVars := getLicensingEnvironmentVariables(spec, resources.Capabilities{ServiceCAAPI: true})
 	assert.False(t, Contains(envVars, validateReporterCertsEnv), "Sender ValidateReporterCerts is disabled, 'VALIDATE_REPORTER_CERTS' environemnt variable should not be added to Licensing pod.")
 }
 
@@ -60,7 +61,7 @@ func TestGetLicensingEnvironmentVariablesCertsValidationEnabledWithCerts(t *test
 		Value: "true",
 	}
 
-	envVars := getLicensingEnvironmentVariables(spec)
+	envVars := getLicensingEnvironmentVariables(spec, resources.Capabilities{ServiceCAAPI: true})
 	assert.True(t, Contains(envVars, validateReporterCertsEnv), "Sender ValidateReporterCerts is enabled, appropriate 'VALIDATE_REPORTER_CERTS' environemnt variable should be added to Licensing pod.")
 }
 
@@ -89,7 +90,7 @@ func TestGetLicensingEnvironmentVariablesNamespaceScopingFeatureEnabled(t *testi
 		Value: "10",
 	}
 
-	envVars := getLicensingEnvironmentVariables(spec)
+	envVars := getLicensingEnvironmentVariables(spec, resources.Capabilities{ServiceCAAPI: true})
 	assert.True(t, Contains(envVars, featureEnabledEnvVar), "Namespaces scoping feature is enabled, environemnt variable 'NAMESPACE_SCOPE_ENABLED' set to true should be added to Licensing pod.")
 	assert.True(t, Contains(envVars, watchNamespacesEnvVar), "Namespaces scoping feature is enabled, appropriate 'WATCH_NAMESPACE' environemnt variable should be added to Licensing pod.")
 	assert.True(t, Contains(envVars, namespaceScopeDenialLimitEnvVar), "Namespaces scoping feature is enabled, appropriate 'NAMESPACE_DENIAL_LIMIT' environemnt variable should be added to Licensing pod.")
@@ -103,7 +104,7 @@ func TestGetLicensingEnvironmentVariablesSoftwareCentralDisabled_NilSpec(t *test
 		Sender:            nil,
 	}
 
-	envVars := getLicensingEnvironmentVariables(spec)
+	envVars := getLicensingEnvironmentVariables(spec, resources.Capabilities{ServiceCAAPI: true})
 	assert.False(t, Contains(envVars, corev1.EnvVar{Name: "SOFTWARE_CENTRAL_ENABLED", Value: "false"}),
 		"Sender is nil, SOFTWARE_CENTRAL_ENABLED should not be added to Licensing pod.")
 	assert.False(t, Contains(envVars, corev1.EnvVar{Name: "SOFTWARE_CENTRAL_URL", Value: softwareCentralProductionURL}),
@@ -122,7 +123,7 @@ func TestGetLicensingEnvironmentVariablesSoftwareCentralEnabled(t *testing.T) {
 		},
 	}
 
-	envVars := getLicensingEnvironmentVariables(spec)
+	envVars := getLicensingEnvironmentVariables(spec, resources.Capabilities{ServiceCAAPI: true})
 	assert.True(t, Contains(envVars, corev1.EnvVar{Name: "SOFTWARE_CENTRAL_ENABLED", Value: "true"}),
 		"SoftwareCentral is enabled, SOFTWARE_CENTRAL_ENABLED=true should be added to Licensing pod.")
 	assert.True(t, Contains(envVars, corev1.EnvVar{Name: "SOFTWARE_CENTRAL_URL", Value: softwareCentralProductionURL}),
@@ -142,7 +143,7 @@ func TestGetLicensingEnvironmentVariablesSoftwareCentralEnabled_SandboxTrue(t *t
 		},
 	}
 
-	envVars := getLicensingEnvironmentVariables(spec)
+	envVars := getLicensingEnvironmentVariables(spec, resources.Capabilities{ServiceCAAPI: true})
 	assert.True(t, Contains(envVars, corev1.EnvVar{Name: "SOFTWARE_CENTRAL_URL", Value: softwareCentralSandboxURL}),
 		"SoftwareCentral.Sandbox is true, SOFTWARE_CENTRAL_URL should point to the sandbox URL.")
 }
@@ -159,7 +160,7 @@ func TestGetLicensingEnvironmentVariablesSoftwareCentralEnabled_WithCustomFreque
 		},
 	}
 
-	envVars := getLicensingEnvironmentVariables(spec)
+	envVars := getLicensingEnvironmentVariables(spec, resources.Capabilities{ServiceCAAPI: true})
 	assert.True(t, Contains(envVars, corev1.EnvVar{Name: "SOFTWARE_CENTRAL_FREQUENCY", Value: "0 0 12 * * *"}),
 		"SoftwareCentral is enabled with custom frequency, SOFTWARE_CENTRAL_FREQUENCY should reflect the custom value.")
 }
@@ -17,15 +17,26 @@
 package service
 
 import (
+	"fmt"
+	"sort"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 
 	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
 )
 
+const (
+	DefaultIBMLicensingName = "instance"
+
+	// DefaultInstanceTemplateKey is the key of the default instance template in the template ConfigMap
+	DefaultInstanceTemplateKey = "instance.yaml"
+)
+
 func GetDefaultIBMLicensing(operatorNamespace string) operatorv1alpha1.IBMLicensing {
 	return operatorv1alpha1.IBMLicensing{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "instance",
+			Name: DefaultIBMLicensingName,
 		},
 		Spec: operatorv1alpha1.IBMLicensingSpec{
 			Datasource:        "datacollector",
@@ -34,3 +45,138 @@ func GetDefaultIBMLicensing(operatorNamespace string) operatorv1alpha1.IBMLicens
 		},
 	}
 }
+
+/*
+GetDefaultIBMLicensingFromTemplate returns the default IBMLicensing instance overridden with the values of the YAML template,
+so that cluster provisioning can bake in its standard configuration (license acceptance, sender, features, labels etc.).
+
+Fields missing in the template keep their default values. Only name, labels, annotations and spec are taken from
+the template metadata, as IBMLicensing is cluster scoped and the rest of metadata is managed by the API server.
+*/
+func GetDefaultIBMLicensingFromTemplate(operatorNamespace string, template []byte) (operatorv1alpha1.IBMLicensing, error) {
+	instance := GetDefaultIBMLicensing(operatorNamespace)
+	if len(template) == 0 {
+		return instance, nil
+	}
+
+	templateInstance := instance.DeepCopy()
+	if err := yaml.UnmarshalStrict(template, templateInstance); err != nil {
+		return instance, fmt.Errorf("invalid default IBMLicensing template: %w", err)
+	}
+	if templateInstance.Kind != "" && templateInstance.Kind != "IBMLicensing" {
+		return instance, fmt.Errorf("invalid default IBMLicensing template: unexpected kind %s", templateInstance.Kind)
+	}
+
+	if templateInstance.Name != "" {
+		instance.Name = templateInstance.Name
+	}
+	instance.Labels = templateInstance.Labels
+	instance.Annotations = templateInstance.Annotations
+	instance.Spec = templateInstance.Spec
+	if instance.Spec.InstanceNamespace == "" {
+		instance.Spec.InstanceNamespace = operatorNamespace
+	}
+	return instance, nil
+}
+
+const (
+	// ActiveInstanceAnnotation marks the IBMLicensing instance which should be active, regardless of priority and age
+	ActiveInstanceAnnotation = "operator.ibm.com/ibmlicensing-active"
+	// PauseReconciliationAnnotation set to "true" stops reconciliation of the instance until it is removed
+	PauseReconciliationAnnotation = "operator.ibm.com/licensing-paused"
+
+	ActiveConditionType = "Active"
+
+	SelectedByAnnotationReason = "SelectedByAnnotation"
+	HighestPriorityReason      = "HighestPriority"
+	OldestInstanceReason       = "OldestInstance"
+	OnlyInstanceReason         = "OnlyInstance"
+)
+
+// IsReconciliationPaused returns true if reconciliation of the instance is paused by the annotation
+func IsReconciliationPaused(instance *operatorv1alpha1.IBMLicensing) bool {
+	return instance.Annotations[PauseReconciliationAnnotation] == "true"
+}
+
+func isSelectedByAnnotation(instance *operatorv1alpha1.IBMLicensing) bool {
+	return instance.Annotations[ActiveInstanceAnnotation] == "true"
+}
+
+/*
+SelectActiveIBMLicensing returns the IBMLicensing instance which should be active, together with the reason of its selection.
+
+Instances annotated with operator.ibm.com/ibmlicensing-active: "true" win over the others, then the one with the highest
+spec.priority, then the oldest one. Instance name is the final tie breaker, so the choice is always deterministic.
+*/
+func SelectActiveIBMLicensing(instances []operatorv1alpha1.IBMLicensing) (*operatorv1alpha1.IBMLicensing, string) {
+	if len(instances) == 0 {
+		return nil, ""
+	}
+
+	candidates := make([]*operatorv1alpha1.IBMLicensing, len(instances))
+	for i := range instances {
+		candidates[i] = &instances[i]
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if isSelectedByAnnotation(a) != isSelectedByAnnotation(b) {
+			return isSelectedByAnnotation(a)
+		}
+		if a.Spec.GetPriority() != b.Spec.GetPriority() {
+			return a.Spec.GetPriority() > b.Spec.GetPriority()
+		}
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+		return a.Name < b.Name
+	})
+
+	active := candidates[0]
+	if len(candidates) == 1 {
+		return active, OnlyInstanceReason
+	}
+
+	runnerUp := candidates[1]
+	if isSelectedByAnnotation(active) != isSelectedByAnnotation(runnerUp) {
+		return active, SelectedByAnnotationReason
+	}
+	if active.Spec.GetPriority() != runnerUp.Spec.GetPriority() {
+		return active, HighestPriorityReason
+	}
+	return active, OldestInstanceReason
+}
+
+func describeActiveSelection(active *operatorv1alpha1.IBMLicensing, reason string) string {
+	switch reason {
+	case SelectedByAnnotationReason:
+		return fmt.Sprintf("it is annotated with %s: \"true\"", ActiveInstanceAnnotation)
+	case HighestPriorityReason:
+		return fmt.Sprintf("it has the highest priority (%d)", active.Spec.GetPriority())
+	case OnlyInstanceReason:
+		return "it is the only IBMLicensing instance"
+	default:
+		return "it was created first"
+	}
+}
+
+// GetActiveCondition returns the condition explaining why the instance is active or which instance is active instead of it
+func GetActiveCondition(instance, active *operatorv1alpha1.IBMLicensing, reason string) metav1.Condition {
+	if instance.UID == active.UID {
+		return metav1.Condition{
+			Type:               ActiveConditionType,
+			Status:             metav1.ConditionTrue,
+			Reason:             reason,
+			Message:            "This instance is active because " + describeActiveSelection(active, reason),
+			ObservedGeneration: instance.Generation,
+		}
+	}
+	return metav1.Condition{
+		Type:   ActiveConditionType,
+		Status: metav1.ConditionFalse,
+		Reason: reason,
+		Message: fmt.Sprintf("IBMLicensing %s is active because %s. This instance is ignored, "+
+			"set a higher spec.priority or the %s annotation to make it active", active.Name, describeActiveSelection(active, reason), ActiveInstanceAnnotation),
+		ObservedGeneration: instance.Generation,
+	}
+}
@@ -0,0 +1,140 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+func ibmLicensingObj(name string, created time.Time, priority *int32, annotations map[string]string) operatorv1alpha1.IBMLicensing {
+	return operatorv1alpha1.IBMLicensing{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			UID:               types.UID(name + "-uid"),
+			CreationTimestamp: metav1.NewTime(created),
+			Annotations:       annotations,
+		},
+		Spec: operatorv1alpha1.IBMLicensingSpec{
+			Priority: priority,
+		},
+	}
+}
+
+func TestSelectActiveIBMLicensing(t *testing.T) {
+	now := time.Now()
+	older := ibmLicensingObj("older", now.Add(-time.Hour), nil, nil)
+	newer := ibmLicensingObj("newer", now, nil, nil)
+	prioritized := ibmLicensingObj("prioritized", now, ptr.To(int32(10)), nil)
+	annotated := ibmLicensingObj("annotated", now, nil, map[string]string{ActiveInstanceAnnotation: "true"})
+
+	active, reason := SelectActiveIBMLicensing(nil)
+	assert.Nil(t, active, "No instance should be selected from an empty list")
+	assert.Empty(t, reason)
+
+	active, reason = SelectActiveIBMLicensing([]operatorv1alpha1.IBMLicensing{newer})
+	assert.Equal(t, "newer", active.Name)
+	assert.Equal(t, OnlyInstanceReason, reason)
+
+	active, reason = SelectActiveIBMLicensing([]operatorv1alpha1.IBMLicensing{newer, older})
+	assert.Equal(t, "older", active.Name, "The oldest instance should be active when no priority is set")
+	assert.Equal(t, OldestInstanceReason, reason)
+
+	active, reason = SelectActiveIBMLicensing([]operatorv1alpha1.IBMLicensing{older, prioritized, newer})
+	assert.Equal(t, "prioritized", active.Name, "The instance with the highest priority should be active")
+	assert.Equal(t, HighestPriorityReason, reason)
+
+	active, reason = SelectActiveIBMLicensing([]operatorv1alpha1.IBMLicensing{older, prioritized, annotated})
+	assert.Equal(t, "annotated", active.Name, "The annotated instance should be active regardless of priority")
+	assert.Equal(t, SelectedByAnnotationReason, reason)
+
+	sameTimeA := ibmLicensingObj("a", now, nil, nil)
+	sameTimeB := ibmLicensingObj("b", now, nil, nil)
+	active, _ = SelectActiveIBMLicensing([]operatorv1alpha1.IBMLicensing{sameTimeB, sameTimeA})
+	assert.Equal(t, "a", active.Name, "Name should be used as a tie breaker")
+}
+
+func TestGetActiveCondition(t *testing.T) {
+	now := time.Now()
+	prioritized := ibmLicensingObj("prioritized", now, ptr.To(int32(5)), nil)
+	other := ibmLicensingObj("other", now.Add(-time.Hour), nil, nil)
+
+	activeCondition := GetActiveCondition(&prioritized, &prioritized, HighestPriorityReason)
+	assert.Equal(t, metav1.ConditionTrue, activeCondition.Status)
+	assert.Equal(t, HighestPriorityReason, activeCondition.Reason)
+
+	inactiveCondition := GetActiveCondition(&other, &prioritized, HighestPriorityReason)
+	assert.Equal(t, metav1.ConditionFalse, inactiveCondition.Status)
+	assert.Equal(t, HighestPriorityReason, inactiveCondition.Reason)
+	assert.Contains(t, inactiveCondition.Message, "IBMLicensing prioritized is active", "Condition should name the active instance")
+	assert.Contains(t, inactiveCondition.Message, "highest priority (5)")
+}
+
+func TestGetDefaultIBMLicensingFromTemplate(t *testing.T) {
+	operatorNamespace := "ibm-licensing"
+
+	instance, err := GetDefaultIBMLicensingFromTemplate(operatorNamespace, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, GetDefaultIBMLicensing(operatorNamespace), instance, "Empty template should produce the default instance")
+
+	template := []byte(`
+apiVersion: operator.ibm.com/v1alpha1
+kind: IBMLicensing
+metadata:
+  name: standard
+  labels:
+    team: platform
+spec:
+  license:
+    accept: true
+  sender:
+    reporterURL: https://reporter.example.com
+`)
+	instance, err = GetDefaultIBMLicensingFromTemplate(operatorNamespace, template)
+	assert.NoError(t, err)
+	assert.Equal(t, "standard", instance.Name)
+	assert.Equal(t, map[string]string{"team": "platform"}, instance.Labels)
+	assert.True(t, instance.Spec.IsLicenseAccepted())
+	assert.Equal(t, "https://reporter.example.com", instance.Spec.Sender.ReporterURL)
+	assert.Equal(t, "datacollector", instance.Spec.Datasource, "Values missing in the template should keep defaults")
+	assert.True(t, instance.Spec.HTTPSEnable, "Values missing in the template should keep defaults")
+	assert.Equal(t, operatorNamespace, instance.Spec.InstanceNamespace)
+
+	_, err = GetDefaultIBMLicensingFromTemplate(operatorNamespace, []byte("spec:\n  unknownField: true\n"))
+	assert.Error(t, err, "Unknown fields in the template should be rejected")
+
+	_, err = GetDefaultIBMLicensingFromTemplate(operatorNamespace, []byte("kind: ConfigMap\n"))
+	assert.Error(t, err, "Template of a different kind should be rejected")
+}
+
+func TestIsReconciliationPaused(t *testing.T) {
+	instance := ibmLicensingObj("instance", time.Now(), nil, nil)
+	assert.False(t, IsReconciliationPaused(&instance))
+
+	instance.Annotations = map[string]string{PauseReconciliationAnnotation: "false"}
+	assert.False(t, IsReconciliationPaused(&instance))
+
+	instance.Annotations[PauseReconciliationAnnotation] = "true"
+	assert.True(t, IsReconciliationPaused(&instance))
+}
@@ -0,0 +1,80 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+const (
+	// ConfigMap generated in instance namespace with namespaces resolved from the namespace scope selector
+	NamespaceScopeConfigMapName = "ibm-licensing-namespace-scope"
+	// Key of the namespaces ConfigMap read by License Service, the same for custom and generated ConfigMaps
+	NamespaceScopeConfigMapKey = "namespaces"
+
+	NamespaceScopeReadyConditionType = "NamespaceScopeReady"
+
+	NamespaceScopeResolvedReason       = "NamespaceScopeResolved"
+	NoNamespacesSelectedReason         = "NoNamespacesSelected"
+	NamespaceDenialLimitExceededReason = "NamespaceDenialLimitExceeded"
+)
+
+// GetNamespaceScopeConfigMap returns ConfigMap publishing the resolved namespaces to License Service
+func GetNamespaceScopeConfigMap(instance *operatorv1alpha1.IBMLicensing, namespaces []string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        NamespaceScopeConfigMapName,
+			Namespace:   instance.Spec.InstanceNamespace,
+			Labels:      LabelsForMeta(instance),
+			Annotations: instance.Spec.Annotations,
+		},
+		Data: map[string]string{NamespaceScopeConfigMapKey: strings.Join(namespaces, ",")},
+	}
+}
+
+/*
+GetNamespaceScopeCondition returns NamespaceScopeReady condition for the resolved scope. Denied namespaces make the
+condition false when their number exceeds spec.features.nssDenialLimit, or when any namespace is denied without a limit.
+*/
+func GetNamespaceScopeCondition(scope operatorv1alpha1.IBMLicensingNamespaceScopeStatus, denialLimit int) metav1.Condition {
+	condition := metav1.Condition{
+		Type:    NamespaceScopeReadyConditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  NamespaceScopeResolvedReason,
+		Message: fmt.Sprintf("%d namespaces selected", len(scope.Namespaces)),
+	}
+	switch {
+	case len(scope.Namespaces) == 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = NoNamespacesSelectedReason
+		condition.Message = "No namespace matches spec.features.nssNamespaceSelector"
+	case len(scope.DeniedNamespaces) > denialLimit:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = NamespaceDenialLimitExceededReason
+		condition.Message = fmt.Sprintf("License Service is not permitted to list pods in %d of %d selected namespaces: %s",
+			len(scope.DeniedNamespaces), len(scope.Namespaces), strings.Join(scope.DeniedNamespaces, ","))
+	case len(scope.DeniedNamespaces) > 0:
+		condition.Message += fmt.Sprintf(", access denied in %s", strings.Join(scope.DeniedNamespaces, ","))
+	}
+	return condition
+}
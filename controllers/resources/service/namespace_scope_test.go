@@ -0,0 +1,73 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources"
+)
+
+func TestGetLicensingEnvironmentVariablesNamespaceScopeSelector(t *testing.T) {
+	spec := operatorv1alpha1.IBMLicensingSpec{
+		InstanceNamespace: "namespace",
+		Features: &operatorv1alpha1.Features{
+			NamespaceScopeEnabled:         ptr.To(true),
+			CustomNamespaceScopeConfigMap: ptr.To("custom-namespaces"),
+			NamespaceScopeSelector:        &metav1.LabelSelector{MatchLabels: map[string]string{"licensing": "true"}},
+		},
+	}
+
+	envVars := getLicensingEnvironmentVariables(spec, resources.Capabilities{})
+	watchNamespaceEnv := corev1.EnvVar{
+		Name: "WATCH_NAMESPACE",
+		ValueFrom: &corev1.EnvVarSource{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				Key:                  NamespaceScopeConfigMapKey,
+				LocalObjectReference: corev1.LocalObjectReference{Name: NamespaceScopeConfigMapName},
+			},
+		},
+	}
+	assert.Contains(t, envVars, watchNamespaceEnv, "Generated ConfigMap should take precedence over nssConfigMap.")
+}
+
+func TestGetNamespaceScopeCondition(t *testing.T) {
+	condition := GetNamespaceScopeCondition(operatorv1alpha1.IBMLicensingNamespaceScopeStatus{}, 0)
+	assert.Equal(t, NoNamespacesSelectedReason, condition.Reason)
+
+	scope := operatorv1alpha1.IBMLicensingNamespaceScopeStatus{Namespaces: []string{"ns1", "ns2", "ns3"}}
+	condition = GetNamespaceScopeCondition(scope, 0)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+
+	scope.DeniedNamespaces = []string{"ns2"}
+	condition = GetNamespaceScopeCondition(scope, 0)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status, "Any denied namespace should be reported without a limit")
+	assert.Equal(t, NamespaceDenialLimitExceededReason, condition.Reason)
+
+	condition = GetNamespaceScopeCondition(scope, 1)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status, "Denied namespaces within the limit should be tolerated")
+	assert.Contains(t, condition.Message, "ns2")
+
+	configMap := GetNamespaceScopeConfigMap(&operatorv1alpha1.IBMLicensing{}, scope.Namespaces)
+	assert.Equal(t, "ns1,ns2,ns3", configMap.Data[NamespaceScopeConfigMapKey])
+}
@@ -0,0 +1,200 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources"
+)
+
+const (
+	OperandConfigurationValidConditionType = "OperandConfigurationValid"
+
+	OperandConfigurationValidReason = "OperandConfigurationValid"
+	EnvVariableConflictReason       = "EnvVariableConflict"
+)
+
+type operandSettingType string
+
+const (
+	boolSetting   operandSettingType = "bool"
+	intSetting    operandSettingType = "int"
+	stringSetting operandSettingType = "string"
+	urlSetting    operandSettingType = "url"
+)
+
+// operandSetting describes environment variable of License Service, which is known to the operator
+type operandSetting struct {
+	Type operandSettingType
+	// Path of the typed spec field the variable is computed from, empty if computed by the operator only
+	SpecField string
+}
+
+/*
+operandSettings is the registry of environment variables computed by the operator. Values of these variables from
+spec.envVariable are validated against their type and compared with the computed value, conflicts are reported, but the
+value from spec.envVariable is still passed to License Service. Unknown variables are passed as they are.
+*/
+var operandSettings = map[string]operandSetting{
+	"NAMESPACE":                        {Type: stringSetting, SpecField: "spec.instanceNamespace"},
+	"DATASOURCE":                       {Type: stringSetting, SpecField: "spec.datasource"},
+	"HTTPS_ENABLE":                     {Type: boolSetting, SpecField: "spec.httpsEnable"},
+	"HTTPS_CERTS_SOURCE":               {Type: stringSetting, SpecField: "spec.httpsCertsSource"},
+	"ENABLE_INSTANA_METRIC_COLLECTION": {Type: boolSetting, SpecField: "spec.enableInstanaMetricCollection"},
+	"logging.level.com.ibm":            {Type: stringSetting, SpecField: "spec.logLevel"},
+	"SPRING_PROFILES_ACTIVE":           {Type: stringSetting, SpecField: "spec.logLevel"},
+	"METERING_URL":                     {Type: urlSetting, SpecField: "spec.datasource"},
+	"enable.metrics":                   {Type: boolSetting, SpecField: "spec.rhmpEnabled"},
+	"enable.alerting":                  {Type: boolSetting, SpecField: "spec.features.alerting.enabled"},
+	"ENABLE_CHARGEBACK":                {Type: boolSetting, SpecField: "spec.chargebackEnabled"},
+	"CONTRIBUTIONS_DATA_RETENTION":     {Type: intSetting, SpecField: "spec.chargebackRetentionPeriod"},
+	"HYPER_THREADING_THREADS_PER_CORE": {Type: intSetting, SpecField: "spec.features.hyperThreading.threadsPerCore"},
+	"NAMESPACE_SCOPE_ENABLED":          {Type: boolSetting, SpecField: "spec.features.nssEnabled"},
+	"WATCH_NAMESPACE":                  {Type: stringSetting, SpecField: "spec.features.nssNamespaceSelector"},
+	"NAMESPACE_DENIAL_LIMIT":           {Type: intSetting, SpecField: "spec.features.nssDenialLimit"},
+	"URL_AUTH_ENABLED":                 {Type: boolSetting, SpecField: "spec.features.auth.urlBasedEnabled"},
+	"PROMETHEUS_QUERY_SOURCE_ENABLED":  {Type: boolSetting, SpecField: "spec.features.prometheusQuerySource.enabled"},
+	"thanos_url":                       {Type: urlSetting, SpecField: "spec.features.prometheusQuerySource.url"},
+	"CLUSTER_ID":                       {Type: stringSetting, SpecField: "spec.sender.clusterID"},
+	"CLUSTER_NAME":                     {Type: stringSetting, SpecField: "spec.sender.clusterName"},
+	"HUB_URL":                          {Type: urlSetting, SpecField: "spec.sender.reporterURL"},
+	"VALIDATE_REPORTER_CERTS":          {Type: boolSetting, SpecField: "spec.sender.validateReporterCerts"},
+	"SENDER_WORKLOADS_INTERVAL":        {Type: stringSetting, SpecField: "spec.sender.frequency"},
+	"SOFTWARE_CENTRAL_ENABLED":         {Type: boolSetting, SpecField: "spec.softwareCentral.enable"},
+	"SOFTWARE_CENTRAL_URL":             {Type: urlSetting, SpecField: "spec.softwareCentral.sandbox"},
+	"SOFTWARE_CENTRAL_FREQUENCY":       {Type: stringSetting, SpecField: "spec.softwareCentral.frequency"},
+	"HTTP_PROXY":                       {Type: urlSetting, SpecField: "spec.outboundNetwork.httpProxy"},
+	"HTTPS_PROXY":                      {Type: urlSetting, SpecField: "spec.outboundNetwork.httpsProxy"},
+	"NO_PROXY":                         {Type: stringSetting, SpecField: "spec.outboundNetwork.noProxy"},
+	"TRUSTED_CA_BUNDLE":                {Type: stringSetting, SpecField: "spec.outboundNetwork.trustedCABundle"},
+}
+
+// Outbound network fields, which used to be configured with spec.envVariable before spec.outboundNetwork was introduced
+var envVariableOutboundNetworkFields = map[string]func(*operatorv1alpha1.IBMLicensingOutboundNetworkSpec) *string{
+	"HTTP_PROXY":  func(outbound *operatorv1alpha1.IBMLicensingOutboundNetworkSpec) *string { return &outbound.HTTPProxy },
+	"HTTPS_PROXY": func(outbound *operatorv1alpha1.IBMLicensingOutboundNetworkSpec) *string { return &outbound.HTTPSProxy },
+	"NO_PROXY":    func(outbound *operatorv1alpha1.IBMLicensingOutboundNetworkSpec) *string { return &outbound.NoProxy },
+}
+
+/*
+MigrateEnvVariableOutboundNetwork moves valid proxy settings from spec.envVariable to spec.outboundNetwork fields,
+which are not set, and returns sorted names of the moved variables. Proxy configured with envVariable before
+outboundNetwork was introduced keeps taking precedence over the OpenShift cluster-wide Proxy this way.
+*/
+func MigrateEnvVariableOutboundNetwork(spec *operatorv1alpha1.IBMLicensingSpec) []string {
+	var migrated []string
+	for _, name := range slices.Sorted(maps.Keys(envVariableOutboundNetworkFields)) {
+		value, found := spec.EnvVariable[name]
+		if !found || value == "" || operandSettings[name].validate(value) != nil {
+			continue
+		}
+		if spec.OutboundNetwork == nil {
+			spec.OutboundNetwork = &operatorv1alpha1.IBMLicensingOutboundNetworkSpec{}
+		}
+		field := envVariableOutboundNetworkFields[name](spec.OutboundNetwork)
+		if *field == "" {
+			*field = value
+			migrated = append(migrated, name)
+		}
+	}
+	return migrated
+}
+
+func (setting operandSetting) validate(value string) error {
+	var err error
+	switch setting.Type {
+	case boolSetting:
+		_, err = strconv.ParseBool(value)
+	case intSetting:
+		_, err = strconv.Atoi(value)
+	case urlSetting:
+		_, err = url.ParseRequestURI(value)
+	}
+	if err != nil {
+		return fmt.Errorf("value %q is not a valid %s", value, setting.Type)
+	}
+	return nil
+}
+
+/*
+MergeOperandEnvVariables merges variables computed by the operator with spec.envVariable and returns them sorted by
+name, so that the container spec does not change with map iteration order. Values from spec.envVariable take precedence,
+as they did when they were appended after the computed ones. Returns also the configured variables, which have an
+invalid value or conflict with the computed value.
+*/
+func MergeOperandEnvVariables(computed []corev1.EnvVar, configured map[string]string) ([]corev1.EnvVar, []string) {
+	merged := map[string]corev1.EnvVar{}
+	for _, envVar := range computed {
+		if _, found := merged[envVar.Name]; !found {
+			merged[envVar.Name] = envVar
+		}
+	}
+
+	var issues []string
+	for _, name := range slices.Sorted(maps.Keys(configured)) {
+		value := configured[name]
+		setting, known := operandSettings[name]
+		computedVar, isComputed := merged[name]
+		if known {
+			if err := setting.validate(value); err != nil {
+				issues = append(issues, fmt.Sprintf("envVariable %s %v, use %s instead", name, err, setting.SpecField))
+			} else if isComputed && (computedVar.ValueFrom != nil || computedVar.Value != value) {
+				issues = append(issues, fmt.Sprintf("envVariable %s overrides the value computed from %s", name, setting.SpecField))
+			}
+		}
+		merged[name] = corev1.EnvVar{Name: name, Value: value}
+	}
+
+	environmentVariables := slices.Collect(maps.Values(merged))
+	sort.Slice(environmentVariables, func(i, j int) bool { return environmentVariables[i].Name < environmentVariables[j].Name })
+	return environmentVariables, issues
+}
+
+// GetOperandConfigurationIssues returns variables from spec.envVariable, which are invalid or override computed values
+func GetOperandConfigurationIssues(spec operatorv1alpha1.IBMLicensingSpec, capabilities resources.Capabilities) []string {
+	_, issues := MergeOperandEnvVariables(getOperatorEnvironmentVariables(spec, capabilities), spec.EnvVariable)
+	return issues
+}
+
+// GetOperandConfigurationCondition returns OperandConfigurationValid condition summarizing the given issues
+func GetOperandConfigurationCondition(issues []string) metav1.Condition {
+	if len(issues) == 0 {
+		return metav1.Condition{
+			Type:    OperandConfigurationValidConditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  OperandConfigurationValidReason,
+			Message: "Variables from envVariable do not conflict with other spec fields",
+		}
+	}
+	return metav1.Condition{
+		Type:    OperandConfigurationValidConditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  EnvVariableConflictReason,
+		Message: strings.Join(issues, "; "),
+	}
+}
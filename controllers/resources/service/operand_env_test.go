@@ -0,0 +1,106 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources"
+)
+
+func TestMergeOperandEnvVariables(t *testing.T) {
+	computed := []corev1.EnvVar{
+		{Name: "NAMESPACE", Value: "namespace"},
+		{Name: "HTTPS_ENABLE", Value: "true"},
+		{Name: "METERING_URL", Value: "https://metering-server:4002/api/v1/metricData"},
+	}
+	configured := map[string]string{
+		"HTTPS_ENABLE":                     "false",
+		"NAMESPACE":                        "namespace",
+		"METERING_URL":                     "https://custom-metering:4002/api/v1/metricData",
+		"HYPER_THREADING_THREADS_PER_CORE": "two",
+		"CLUSTER_NAME":                     "cluster",
+		"CUSTOM_SETTING":                   "value",
+	}
+
+	envVars, issues := MergeOperandEnvVariables(computed, configured)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "CLUSTER_NAME", Value: "cluster"},
+		{Name: "CUSTOM_SETTING", Value: "value"},
+		{Name: "HTTPS_ENABLE", Value: "false"},
+		{Name: "HYPER_THREADING_THREADS_PER_CORE", Value: "two"},
+		{Name: "METERING_URL", Value: "https://custom-metering:4002/api/v1/metricData"},
+		{Name: "NAMESPACE", Value: "namespace"},
+	}, envVars, "Variables should be sorted, configured ones should take precedence over computed ones")
+	assert.Equal(t, []string{
+		"envVariable HTTPS_ENABLE overrides the value computed from spec.httpsEnable",
+		"envVariable HYPER_THREADING_THREADS_PER_CORE value \"two\" is not a valid int, use spec.features.hyperThreading.threadsPerCore instead",
+		"envVariable METERING_URL overrides the value computed from spec.datasource",
+	}, issues)
+}
+
+func TestOperatorEnvironmentVariablesAreRegistered(t *testing.T) {
+	spec := operatorv1alpha1.IBMLicensingSpec{
+		IBMLicenseServiceBaseSpec: operatorv1alpha1.IBMLicenseServiceBaseSpec{LogLevel: "DEBUG"},
+		InstanceNamespace:         "namespace",
+		HTTPSEnable:               true,
+		Sender: &operatorv1alpha1.IBMLicensingSenderSpec{
+			ReporterURL:           "https://reporter:8080",
+			ClusterID:             "id",
+			ClusterName:           "name",
+			ValidateReporterCerts: true,
+			Frequency:             "10m",
+		},
+		SoftwareCentral: &operatorv1alpha1.IBMLicensingSoftwareCentralSpec{Enable: true, EntitlementKeySecret: "key"},
+		OutboundNetwork: &operatorv1alpha1.IBMLicensingOutboundNetworkSpec{HTTPSProxy: "http://proxy:3128", TrustedCABundle: "bundle"},
+	}
+	for _, envVar := range getOperatorEnvironmentVariables(spec, resources.Capabilities{}) {
+		_, registered := operandSettings[envVar.Name]
+		assert.True(t, registered, "Variable %s should be registered in operandSettings", envVar.Name)
+	}
+	assert.Empty(t, GetOperandConfigurationIssues(spec, resources.Capabilities{}))
+
+	spec.EnvVariable = map[string]string{"CLUSTER_ID": "other-id"}
+	condition := GetOperandConfigurationCondition(GetOperandConfigurationIssues(spec, resources.Capabilities{}))
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, EnvVariableConflictReason, condition.Reason)
+}
+
+func TestMigrateEnvVariableOutboundNetwork(t *testing.T) {
+	spec := operatorv1alpha1.IBMLicensingSpec{EnvVariable: map[string]string{"LOG": "debug"}}
+	assert.Empty(t, MigrateEnvVariableOutboundNetwork(&spec))
+	assert.Nil(t, spec.OutboundNetwork, "Outbound network should not be configured without proxy variables")
+
+	spec.EnvVariable = map[string]string{
+		"HTTPS_PROXY": "http://user-proxy:8080",
+		"HTTP_PROXY":  "not a url",
+		"NO_PROXY":    "example.com",
+	}
+	spec.OutboundNetwork = &operatorv1alpha1.IBMLicensingOutboundNetworkSpec{NoProxy: "internal.example.com"}
+	assert.Equal(t, []string{"HTTPS_PROXY"}, MigrateEnvVariableOutboundNetwork(&spec))
+	assert.Equal(t, "http://user-proxy:8080", spec.OutboundNetwork.HTTPSProxy)
+	assert.Empty(t, spec.OutboundNetwork.HTTPProxy, "Invalid proxy should not be moved")
+	assert.Equal(t, "internal.example.com", spec.OutboundNetwork.NoProxy, "Fields of outbound network should take precedence")
+
+	_, issues := MergeOperandEnvVariables(getOutboundNetworkEnvironmentVariables(spec.OutboundNetwork), map[string]string{"HTTPS_PROXY": "http://user-proxy:8080"})
+	assert.Empty(t, issues, "Moved variable should not conflict with the computed one")
+}
@@ -0,0 +1,142 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"golang.org/x/net/http/httpproxy"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+const (
+	// ConfigMap created in instance namespace, which OpenShift fills with the trusted CA bundle of the cluster-wide Proxy
+	TrustedCABundleConfigMapName = "ibm-licensing-trusted-ca-bundle"
+	TrustedCABundleKey           = "ca-bundle.crt"
+	InjectTrustedCABundleLabel   = "config.openshift.io/inject-trusted-cabundle"
+	TrustedCABundleMountPath     = "/opt/ibm/licensing/trusted-ca"
+
+	// Name of the OpenShift cluster-wide Proxy
+	ClusterProxyName = "cluster"
+)
+
+// Hosts and domains always connected to without the proxy, so that in-cluster services are reachable
+var requiredNoProxy = []string{".svc", ".cluster.local", "localhost", "127.0.0.1"}
+
+/*
+MergeClusterProxy returns outbound network configuration with fields not set by the user taken from the status of the
+OpenShift cluster-wide Proxy. When the Proxy has a trusted CA and no bundle is configured, the ConfigMap injected by
+OpenShift is used. Returns the original configuration if the Proxy does not define anything.
+*/
+func MergeClusterProxy(outbound *operatorv1alpha1.IBMLicensingOutboundNetworkSpec, proxy *configv1.Proxy) *operatorv1alpha1.IBMLicensingOutboundNetworkSpec {
+	if proxy == nil || (proxy.Status.HTTPProxy == "" && proxy.Status.HTTPSProxy == "" && proxy.Spec.TrustedCA.Name == "") {
+		return outbound
+	}
+	merged := &operatorv1alpha1.IBMLicensingOutboundNetworkSpec{}
+	if outbound != nil {
+		merged = outbound.DeepCopy()
+	}
+	if merged.HTTPProxy == "" {
+		merged.HTTPProxy = proxy.Status.HTTPProxy
+	}
+	if merged.HTTPSProxy == "" {
+		merged.HTTPSProxy = proxy.Status.HTTPSProxy
+	}
+	if merged.NoProxy == "" {
+		merged.NoProxy = proxy.Status.NoProxy
+	}
+	if merged.TrustedCABundle == "" && proxy.Spec.TrustedCA.Name != "" {
+		merged.TrustedCABundle = TrustedCABundleConfigMapName
+	}
+	return merged
+}
+
+// GetNoProxy returns the configured no proxy list extended with in-cluster domains, without duplicates
+func GetNoProxy(noProxy string) string {
+	var entries []string
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry != "" && !slices.Contains(entries, entry) {
+			entries = append(entries, entry)
+		}
+	}
+	for _, entry := range requiredNoProxy {
+		if !slices.Contains(entries, entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return strings.Join(entries, ",")
+}
+
+/*
+GetProxyFunc returns proxy selection for outbound requests of the operator made on behalf of License Service, with
+the same proxies as passed to License Service by environment variables. Returns nil if no proxy is configured.
+*/
+func GetProxyFunc(outbound *operatorv1alpha1.IBMLicensingOutboundNetworkSpec) func(*http.Request) (*url.URL, error) {
+	if outbound == nil || (outbound.HTTPProxy == "" && outbound.HTTPSProxy == "") {
+		return nil
+	}
+	proxyConfig := httpproxy.Config{HTTPProxy: outbound.HTTPProxy, HTTPSProxy: outbound.HTTPSProxy, NoProxy: GetNoProxy(outbound.NoProxy)}
+	proxyFunc := proxyConfig.ProxyFunc()
+	return func(request *http.Request) (*url.URL, error) {
+		return proxyFunc(request.URL)
+	}
+}
+
+// GetTrustedCABundleConfigMap returns empty ConfigMap labeled for injection of the cluster trusted CA bundle by OpenShift
+func GetTrustedCABundleConfigMap(instance *operatorv1alpha1.IBMLicensing) *corev1.ConfigMap {
+	metaLabels := LabelsForMeta(instance)
+	metaLabels[InjectTrustedCABundleLabel] = "true"
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        TrustedCABundleConfigMapName,
+			Namespace:   instance.Spec.InstanceNamespace,
+			Labels:      metaLabels,
+			Annotations: instance.Spec.Annotations,
+		},
+	}
+}
+
+func getOutboundNetworkEnvironmentVariables(outbound *operatorv1alpha1.IBMLicensingOutboundNetworkSpec) []corev1.EnvVar {
+	if outbound == nil {
+		return nil
+	}
+	var environmentVariables []corev1.EnvVar
+	if outbound.HTTPProxy != "" || outbound.HTTPSProxy != "" {
+		if outbound.HTTPProxy != "" {
+			environmentVariables = append(environmentVariables, corev1.EnvVar{Name: "HTTP_PROXY", Value: outbound.HTTPProxy})
+		}
+		if outbound.HTTPSProxy != "" {
+			environmentVariables = append(environmentVariables, corev1.EnvVar{Name: "HTTPS_PROXY", Value: outbound.HTTPSProxy})
+		}
+		environmentVariables = append(environmentVariables, corev1.EnvVar{Name: "NO_PROXY", Value: GetNoProxy(outbound.NoProxy)})
+	}
+	if outbound.TrustedCABundle != "" {
+		environmentVariables = append(environmentVariables, corev1.EnvVar{
+			Name:  "TRUSTED_CA_BUNDLE",
+			Value: TrustedCABundleMountPath + "/" + TrustedCABundleKey,
+		})
+	}
+	return environmentVariables
+}
@@ -0,0 +1,90 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"net/http"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+func TestMergeClusterProxy(t *testing.T) {
+	assert.Nil(t, MergeClusterProxy(nil, nil))
+	assert.Nil(t, MergeClusterProxy(nil, &configv1.Proxy{}), "Proxy without settings should not configure anything")
+
+	proxy := &configv1.Proxy{
+		Spec: configv1.ProxySpec{TrustedCA: configv1.ConfigMapNameReference{Name: "user-ca-bundle"}},
+		Status: configv1.ProxyStatus{
+			HTTPProxy:  "http://proxy:3128",
+			HTTPSProxy: "http://proxy:3128",
+			NoProxy:    ".cluster.local,.svc,10.0.0.0/16",
+		},
+	}
+	merged := MergeClusterProxy(nil, proxy)
+	assert.Equal(t, "http://proxy:3128", merged.HTTPSProxy)
+	assert.Equal(t, ".cluster.local,.svc,10.0.0.0/16", merged.NoProxy)
+	assert.Equal(t, TrustedCABundleConfigMapName, merged.TrustedCABundle, "Injected bundle should be used for the Proxy trusted CA")
+
+	outbound := &operatorv1alpha1.IBMLicensingOutboundNetworkSpec{HTTPSProxy: "http://user-proxy:8080", TrustedCABundle: "user-bundle"}
+	merged = MergeClusterProxy(outbound, proxy)
+	assert.Equal(t, "http://user-proxy:8080", merged.HTTPSProxy, "User settings should take precedence")
+	assert.Equal(t, "http://proxy:3128", merged.HTTPProxy)
+	assert.Equal(t, "user-bundle", merged.TrustedCABundle)
+	assert.Empty(t, outbound.HTTPProxy, "Original configuration should not be modified")
+}
+
+func TestOutboundNetworkEnvironmentVariables(t *testing.T) {
+	assert.Equal(t, ".svc,.cluster.local,localhost,127.0.0.1", GetNoProxy(""))
+	assert.Equal(t, "example.com,.svc,.cluster.local,localhost,127.0.0.1", GetNoProxy(" example.com, .svc,example.com"))
+
+	assert.Empty(t, getOutboundNetworkEnvironmentVariables(nil))
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "HTTPS_PROXY", Value: "http://proxy:3128"},
+		{Name: "NO_PROXY", Value: ".svc,.cluster.local,localhost,127.0.0.1"},
+		{Name: "TRUSTED_CA_BUNDLE", Value: "/opt/ibm/licensing/trusted-ca/ca-bundle.crt"},
+	}, getOutboundNetworkEnvironmentVariables(&operatorv1alpha1.IBMLicensingOutboundNetworkSpec{
+		HTTPSProxy:      "http://proxy:3128",
+		TrustedCABundle: "bundle",
+	}))
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "TRUSTED_CA_BUNDLE", Value: "/opt/ibm/licensing/trusted-ca/ca-bundle.crt"},
+	}, getOutboundNetworkEnvironmentVariables(&operatorv1alpha1.IBMLicensingOutboundNetworkSpec{TrustedCABundle: "bundle"}),
+		"No proxy variables should be set without a proxy")
+}
+
+func TestGetProxyFunc(t *testing.T) {
+	assert.Nil(t, GetProxyFunc(nil))
+	assert.Nil(t, GetProxyFunc(&operatorv1alpha1.IBMLicensingOutboundNetworkSpec{TrustedCABundle: "bundle"}))
+
+	proxyFunc := GetProxyFunc(&operatorv1alpha1.IBMLicensingOutboundNetworkSpec{HTTPSProxy: "http://proxy:3128", NoProxy: "internal.example.com"})
+	request, _ := http.NewRequest(http.MethodGet, "https://reporter.example.com", nil)
+	proxyURL, err := proxyFunc(request)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://proxy:3128", proxyURL.String())
+
+	for _, direct := range []string{"https://internal.example.com", "https://reporter.ibm-licensing.svc", "http://reporter.example.com"} {
+		request, _ = http.NewRequest(http.MethodGet, direct, nil)
+		proxyURL, err = proxyFunc(request)
+		assert.NoError(t, err)
+		assert.Nil(t, proxyURL, "Request to %s should not use the proxy", direct)
+	}
+}
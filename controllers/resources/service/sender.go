@@ -0,0 +1,204 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+const (
+	SenderReadyConditionType = "SenderReady"
+
+	ReporterReachableReason     = "ReporterReachable"
+	InvalidReporterURLReason    = "InvalidReporterURL"
+	ReporterTokenMissingReason  = "ReporterTokenMissing"
+	ReporterCertsInvalidReason  = "ReporterCertsInvalid"
+	ReporterUnreachableReason   = "ReporterUnreachable"
+	ReporterTokenRejectedReason = "ReporterTokenRejected"
+	ReporterErrorResponseReason = "ReporterErrorResponse"
+)
+
+// ReporterCheckTimeout is the timeout of a single connectivity check against License Service Reporter
+const ReporterCheckTimeout = 10 * time.Second
+
+// ReporterCheck contains everything needed to verify the connection of the sender to License Service Reporter
+type ReporterCheck struct {
+	ReporterURL string
+	// Token used by the sender, empty if the token secret does not exist
+	Token string
+	// PEM encoded certificates of the reporter, used as trusted roots when ValidateCerts is set
+	Certs         []byte
+	ValidateCerts bool
+	// Proxy and trusted CA bundle used by the sender, merged with the OpenShift cluster-wide Proxy
+	OutboundNetwork *operatorv1alpha1.IBMLicensingOutboundNetworkSpec
+	// PEM encoded certificates from the trusted CA bundle of outbound network, trusted in addition to the system ones
+	TrustedCAs []byte
+}
+
+/*
+GetReporterToken returns token from License Service Reporter token secret. The token is expected under the "token" key,
+or under the only key of the secret.
+*/
+func GetReporterToken(secret *corev1.Secret) (string, error) {
+	if token, found := secret.Data[APISecretTokenKeyName]; found && len(token) > 0 {
+		return string(token), nil
+	}
+	if len(secret.Data) == 1 {
+		for _, token := range secret.Data {
+			if len(token) > 0 {
+				return string(token), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("secret %s does not contain the %s key", secret.Name, APISecretTokenKeyName)
+}
+
+/*
+GetReporterCerts returns all PEM certificates from License Service Reporter certificates secret. Returns an error if the
+secret does not contain any certificate, or if any of the certificates cannot be parsed or is expired.
+*/
+func GetReporterCerts(secret *corev1.Secret, now time.Time) ([]byte, error) {
+	var certs []byte
+	for _, key := range slices.Sorted(maps.Keys(secret.Data)) {
+		rest := secret.Data[key]
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("certificate under %s key of secret %s cannot be parsed: %w", key, secret.Name, err)
+			}
+			if now.After(cert.NotAfter) {
+				return nil, fmt.Errorf("certificate %s under %s key of secret %s expired at %s", cert.Subject, key, secret.Name, cert.NotAfter.Format(time.RFC3339))
+			}
+			certs = append(certs, pem.EncodeToMemory(block)...)
+		}
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("secret %s does not contain any PEM certificate", secret.Name)
+	}
+	return certs, nil
+}
+
+/*
+CheckReporterConnectivity performs TLS handshake and authenticated request against License Service Reporter URL,
+the same way as the sender does. Returns SenderReady condition with the diagnostics in its message.
+Successful or redirect response proves the reporter is reachable and accepts the token, 401 and 403 mean the token
+is rejected, and any other error response means the reporter cannot accept data, f.e. the URL path is wrong.
+*/
+func CheckReporterConnectivity(ctx context.Context, check ReporterCheck) metav1.Condition {
+	condition := metav1.Condition{Type: SenderReadyConditionType, Status: metav1.ConditionFalse}
+
+	reporterURL, err := url.Parse(check.ReporterURL)
+	if err != nil || reporterURL.Host == "" || (reporterURL.Scheme != "https" && reporterURL.Scheme != "http") {
+		condition.Reason = InvalidReporterURLReason
+		condition.Message = fmt.Sprintf("Reporter URL %q must be an absolute http or https URL", check.ReporterURL)
+		return condition
+	}
+	if check.Token == "" {
+		condition.Reason = ReporterTokenMissingReason
+		condition.Message = "Reporter token is missing"
+		return condition
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if check.ValidateCerts {
+		// Certificates of the reporter replace the system ones, trusted CA bundle extends them, f.e. for a TLS intercepting proxy
+		rootCAs := x509.NewCertPool()
+		if systemCAs, err := x509.SystemCertPool(); err == nil && len(check.Certs) == 0 {
+			rootCAs = systemCAs
+		}
+		for _, certs := range []struct {
+			pem         []byte
+			description string
+		}{{check.Certs, "Reporter certificates"}, {check.TrustedCAs, "Trusted CA bundle"}} {
+			if len(certs.pem) > 0 && !rootCAs.AppendCertsFromPEM(certs.pem) {
+				condition.Reason = ReporterCertsInvalidReason
+				condition.Message = certs.description + " cannot be loaded"
+				return condition
+			}
+		}
+		if len(check.Certs) > 0 || len(check.TrustedCAs) > 0 {
+			tlsConfig.RootCAs = rootCAs
+		}
+	} else {
+		// Same as the sender, which does not validate certificates of the reporter unless requested
+		tlsConfig.InsecureSkipVerify = true // #nosec G402
+	}
+	httpClient := &http.Client{
+		Timeout:   ReporterCheckTimeout,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig, Proxy: GetProxyFunc(check.OutboundNetwork)},
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, reporterURL.String(), nil)
+	if err != nil {
+		condition.Reason = InvalidReporterURLReason
+		condition.Message = err.Error()
+		return condition
+	}
+	request.Header.Set("Authorization", "Bearer "+check.Token)
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		condition.Reason = ReporterUnreachableReason
+		condition.Message = fmt.Sprintf("Cannot connect to reporter %s: %v", reporterURL.Host, err)
+		var certErr *tls.CertificateVerificationError
+		if errors.As(err, &certErr) {
+			condition.Reason = ReporterCertsInvalidReason
+			condition.Message = fmt.Sprintf("Certificate of reporter %s is not trusted: %v", reporterURL.Host, certErr.Err)
+		}
+		return condition
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+		condition.Reason = ReporterTokenRejectedReason
+		condition.Message = fmt.Sprintf("Reporter %s rejected the token with status %s", reporterURL.Host, response.Status)
+		return condition
+	}
+	if response.StatusCode >= http.StatusBadRequest {
+		condition.Reason = ReporterErrorResponseReason
+		condition.Message = fmt.Sprintf("Reporter %s responded with error status %s", reporterURL.Host, response.Status)
+		return condition
+	}
+
+	condition.Status = metav1.ConditionTrue
+	condition.Reason = ReporterReachableReason
+	condition.Message = fmt.Sprintf("Reporter %s is reachable and responded with status %s", reporterURL.Host, response.Status)
+	return condition
+}
@@ -0,0 +1,117 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+func newReporterStandIn(t *testing.T, validToken string) (*httptest.Server, []byte) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path == "/unavailable" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	certs := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return server, certs
+}
+
+func TestCheckReporterConnectivity(t *testing.T) {
+	server, certs := newReporterStandIn(t, "valid-token")
+	ctx := context.Background()
+
+	condition := CheckReporterConnectivity(ctx, ReporterCheck{ReporterURL: server.URL, Token: "valid-token", Certs: certs, ValidateCerts: true})
+	assert.Equal(t, metav1.ConditionTrue, condition.Status, condition.Message)
+	assert.Equal(t, ReporterReachableReason, condition.Reason)
+
+	condition = CheckReporterConnectivity(ctx, ReporterCheck{ReporterURL: server.URL, Token: "other-token", Certs: certs, ValidateCerts: true})
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, ReporterTokenRejectedReason, condition.Reason)
+
+	condition = CheckReporterConnectivity(ctx, ReporterCheck{ReporterURL: server.URL + "/unavailable", Token: "valid-token", Certs: certs, ValidateCerts: true})
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, ReporterErrorResponseReason, condition.Reason)
+
+	condition = CheckReporterConnectivity(ctx, ReporterCheck{ReporterURL: server.URL, Token: "valid-token", ValidateCerts: true})
+	assert.Equal(t, ReporterCertsInvalidReason, condition.Reason, "Self-signed certificate of the reporter should not be trusted without its certs")
+
+	condition = CheckReporterConnectivity(ctx, ReporterCheck{ReporterURL: server.URL, Token: "valid-token"})
+	assert.Equal(t, metav1.ConditionTrue, condition.Status, "Certificates should not be validated unless requested")
+
+	condition = CheckReporterConnectivity(ctx, ReporterCheck{ReporterURL: server.URL, Token: "valid-token", ValidateCerts: true, TrustedCAs: certs})
+	assert.Equal(t, metav1.ConditionTrue, condition.Status, "Certificates from trusted CA bundle should be trusted")
+
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.Host
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(proxy.Close)
+	condition = CheckReporterConnectivity(ctx, ReporterCheck{ReporterURL: "https://reporter.example.com", Token: "valid-token",
+		OutboundNetwork: &operatorv1alpha1.IBMLicensingOutboundNetworkSpec{HTTPSProxy: proxy.URL}})
+	assert.Equal(t, ReporterUnreachableReason, condition.Reason)
+	assert.Equal(t, "reporter.example.com:443", proxiedHost, "Reporter should be connected to through the proxy")
+
+	condition = CheckReporterConnectivity(ctx, ReporterCheck{ReporterURL: server.URL})
+	assert.Equal(t, ReporterTokenMissingReason, condition.Reason)
+
+	condition = CheckReporterConnectivity(ctx, ReporterCheck{ReporterURL: "reporter.example.com", Token: "valid-token"})
+	assert.Equal(t, InvalidReporterURLReason, condition.Reason)
+
+	server.Close()
+	condition = CheckReporterConnectivity(ctx, ReporterCheck{ReporterURL: server.URL, Token: "valid-token"})
+	assert.Equal(t, ReporterUnreachableReason, condition.Reason)
+}
+
+func TestGetReporterSecrets(t *testing.T) {
+	_, certs := newReporterStandIn(t, "")
+
+	token, err := GetReporterToken(&corev1.Secret{Data: map[string][]byte{"token": []byte("abc")}})
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", token)
+	token, err = GetReporterToken(&corev1.Secret{Data: map[string][]byte{"other": []byte("def")}})
+	assert.NoError(t, err)
+	assert.Equal(t, "def", token, "The only key of the secret should be used as the token")
+	_, err = GetReporterToken(&corev1.Secret{Data: map[string][]byte{"a": []byte("1"), "b": []byte("2")}})
+	assert.Error(t, err)
+
+	found, err := GetReporterCerts(&corev1.Secret{Data: map[string][]byte{"ca.crt": certs}}, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, certs, found)
+	_, err = GetReporterCerts(&corev1.Secret{Data: map[string][]byte{"ca.crt": certs}}, time.Now().AddDate(100, 0, 0))
+	assert.ErrorContains(t, err, "expired")
+	_, err = GetReporterCerts(&corev1.Secret{Data: map[string][]byte{"ca.crt": []byte("not a certificate")}}, time.Now())
+	assert.Error(t, err)
+}
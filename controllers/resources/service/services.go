@@ -35,10 +35,10 @@ var (
 	prometheusTargetPortName = intstr.FromString("metrics")
 )
 
-func GetServices(instance *operatorv1alpha1.IBMLicensing) (expected []*corev1.Service, notExpected []*corev1.Service) {
-	expected = append(expected, GetLicensingService(instance))
+func GetServices(instance *operatorv1alpha1.IBMLicensing, capabilities resources.Capabilities) (expected []*corev1.Service, notExpected []*corev1.Service) {
+	expected = append(expected, GetLicensingService(instance, capabilities))
 
-	prometheusService := GetPrometheusService(instance)
+	prometheusService := GetPrometheusService(instance, capabilities)
 	if instance.Spec.IsPrometheusServiceNeeded() {
 		expected = append(expected, prometheusService)
 	} else {
@@ -52,14 +52,14 @@ func GetLicensingServiceName(instance *operatorv1alpha1.IBMLicensing) string {
 	return GetResourceName(instance)
 }
 
-func GetLicensingService(instance *operatorv1alpha1.IBMLicensing) *corev1.Service {
+func GetLicensingService(instance *operatorv1alpha1.IBMLicensing, capabilities resources.Capabilities) *corev1.Service {
 	metaLabels := LabelsForMeta(instance)
 	return &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        GetLicensingServiceName(instance),
 			Namespace:   instance.Spec.InstanceNamespace,
 			Labels:      metaLabels,
-			Annotations: resources.AnnotateForService(instance, LicenseServiceInternalCertName),
+			Annotations: resources.AnnotateForService(instance, LicenseServiceInternalCertName, capabilities),
 		},
 		Spec: corev1.ServiceSpec{
 			Type: corev1.ServiceTypeClusterIP,
@@ -80,13 +80,13 @@ func GetPrometheusServiceName() string {
 	return PrometheusServiceName
 }
 
-func GetPrometheusService(instance *operatorv1alpha1.IBMLicensing) *corev1.Service {
+func GetPrometheusService(instance *operatorv1alpha1.IBMLicensing, capabilities resources.Capabilities) *corev1.Service {
 	return &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        GetPrometheusServiceName(),
 			Namespace:   instance.Spec.InstanceNamespace,
 			Labels:      getPrometheusLabels(instance),
-			Annotations: resources.AnnotateForService(instance, PrometheusServiceOCPCertName),
+			Annotations: resources.AnnotateForService(instance, PrometheusServiceOCPCertName, capabilities),
 		},
 		Spec: corev1.ServiceSpec{
 			Type: corev1.ServiceTypeClusterIP,
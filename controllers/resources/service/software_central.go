@@ -0,0 +1,89 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+const (
+	SoftwareCentralReadyConditionType = "SoftwareCentralReady"
+
+	SoftwareCentralConfiguredReason = "SoftwareCentralConfigured"
+	EntitlementKeyMissingReason     = "EntitlementKeyMissing"
+	InvalidUploadFrequencyReason    = "InvalidUploadFrequency"
+)
+
+// Parser of the Spring Boot cron expressions used by License Service, with seconds and the @ descriptors
+var softwareCentralCronParser = cron.NewParser(
+	cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+/*
+ParseSoftwareCentralFrequency parses the upload frequency the same way it is passed to License Service, so that
+expressions accepted by the CRD pattern but rejected by the scheduler (f.e. 32nd day of month) are reported early.
+*/
+func ParseSoftwareCentralFrequency(swc *operatorv1alpha1.IBMLicensingSoftwareCentralSpec) (cron.Schedule, error) {
+	frequency := getSoftwareCentralFrequency(swc)
+	schedule, err := softwareCentralCronParser.Parse(frequency)
+	if err != nil {
+		return nil, fmt.Errorf("spec.softwareCentral.frequency %q is not a valid cron expression: %w", swc.Frequency, err)
+	}
+	return schedule, nil
+}
+
+/*
+CheckEntitlementKey returns an error if the entitlement key secret does not contain a non-empty value. All keys of the
+secret are mounted to /opt/ibm/licensing/swc-entitlement-key, so the name of the key is not checked.
+*/
+func CheckEntitlementKey(secret *corev1.Secret) error {
+	for _, value := range secret.Data {
+		if len(value) > 0 {
+			return nil
+		}
+	}
+	for _, value := range secret.StringData {
+		if value != "" {
+			return nil
+		}
+	}
+	return fmt.Errorf("entitlement key secret %s does not contain the entitlement key", secret.Name)
+}
+
+/*
+GetSoftwareCentralCondition returns SoftwareCentralReady condition summarizing validation of the configuration.
+*/
+func GetSoftwareCentralCondition(validationReason string, validationErr error) metav1.Condition {
+	condition := metav1.Condition{
+		Type:    SoftwareCentralReadyConditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  SoftwareCentralConfiguredReason,
+		Message: "Software Central integration is configured",
+	}
+	if validationErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = validationReason
+		condition.Message = validationErr.Error()
+	}
+	return condition
+}
@@ -0,0 +1,72 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+func TestParseSoftwareCentralFrequency(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	schedule, err := ParseSoftwareCentralFrequency(&operatorv1alpha1.IBMLicensingSoftwareCentralSpec{})
+	assert.NoError(t, err, "Default frequency should be valid")
+	assert.Equal(t, time.Date(2026, 3, 11, 0, 5, 0, 0, time.UTC), schedule.Next(now))
+
+	schedule, err = ParseSoftwareCentralFrequency(&operatorv1alpha1.IBMLicensingSoftwareCentralSpec{Frequency: "30 15 10 * * *"})
+	assert.NoError(t, err, "Frequency with seconds should be valid")
+	assert.Equal(t, time.Date(2026, 3, 11, 10, 15, 30, 0, time.UTC), schedule.Next(now))
+
+	schedule, err = ParseSoftwareCentralFrequency(&operatorv1alpha1.IBMLicensingSoftwareCentralSpec{Frequency: "@hourly"})
+	assert.NoError(t, err, "Descriptor should be valid")
+	assert.Equal(t, time.Date(2026, 3, 10, 13, 0, 0, 0, time.UTC), schedule.Next(now))
+
+	_, err = ParseSoftwareCentralFrequency(&operatorv1alpha1.IBMLicensingSoftwareCentralSpec{Frequency: "5 0 32 * *"})
+	assert.Error(t, err, "Day of month out of range should be rejected")
+	_, err = ParseSoftwareCentralFrequency(&operatorv1alpha1.IBMLicensingSoftwareCentralSpec{Frequency: "0 70 * * *"})
+	assert.Error(t, err, "Minute out of range should be rejected")
+}
+
+func TestCheckEntitlementKey(t *testing.T) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "entitlement"}}
+	assert.Error(t, CheckEntitlementKey(secret), "Empty secret should be rejected")
+
+	secret.Data = map[string][]byte{"key": []byte("")}
+	assert.Error(t, CheckEntitlementKey(secret), "Empty key should be rejected")
+
+	secret.Data["entitlement-key"] = []byte("entitlement-key")
+	assert.NoError(t, CheckEntitlementKey(secret), "Key of any name should be accepted")
+}
+
+func TestGetSoftwareCentralCondition(t *testing.T) {
+	condition := GetSoftwareCentralCondition("", nil)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, SoftwareCentralConfiguredReason, condition.Reason)
+
+	condition = GetSoftwareCentralCondition(EntitlementKeyMissingReason, errors.New("missing key"))
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, EntitlementKeyMissingReason, condition.Reason)
+	assert.Equal(t, "missing key", condition.Message)
+}
@@ -0,0 +1,128 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+// Annotation of IBMLicensing, change of which value triggers rotation of API and upload tokens
+const RotateTokensAnnotation = "operator.ibm.com/licensing-rotate-tokens"
+
+// Annotations of token secrets tracking the rotation
+const (
+	TokenRotatedAtAnnotation         = "operator.ibm.com/licensing-token-rotated-at"
+	PreviousTokenExpiresAnnotation   = "operator.ibm.com/licensing-previous-token-expires"
+	RotationTriggerHandledAnnotation = "operator.ibm.com/licensing-rotation-trigger"
+)
+
+// Suffix of secret key with the previous token, which stays valid during the overlap period
+const PreviousTokenKeySuffix = "-previous"
+
+/*
+IsTokenRotationDue returns true when token secret should be rotated, either because the rotation interval elapsed
+since the last rotation (or creation of the secret), or because rotation annotation of the instance has a new value.
+*/
+func IsTokenRotationDue(instance *operatorv1alpha1.IBMLicensing, secret *corev1.Secret, now time.Time) bool {
+	if !instance.Spec.IsTokenRotationEnabled() {
+		return false
+	}
+	if trigger := instance.Annotations[RotateTokensAnnotation]; trigger != "" && trigger != secret.Annotations[RotationTriggerHandledAnnotation] {
+		return true
+	}
+	interval := instance.Spec.GetTokenRotationInterval()
+	return interval > 0 && !now.Before(getTokenRotatedAt(secret).Add(interval))
+}
+
+/*
+RotateToken generates new token under the key and keeps the current one under the key with PreviousTokenKeySuffix,
+until the overlap period of the instance passes.
+*/
+func RotateToken(instance *operatorv1alpha1.IBMLicensing, secret *corev1.Secret, key string, now time.Time) error {
+	newToken, err := randString(24)
+	if err != nil {
+		return err
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	if current, found := secret.Data[key]; found {
+		secret.Data[key+PreviousTokenKeySuffix] = current
+	}
+	secret.Data[key] = []byte(newToken)
+	secret.StringData = nil
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[TokenRotatedAtAnnotation] = now.UTC().Format(time.RFC3339)
+	secret.Annotations[PreviousTokenExpiresAnnotation] = now.Add(instance.Spec.GetTokenOverlapPeriod()).UTC().Format(time.RFC3339)
+	if trigger := instance.Annotations[RotateTokensAnnotation]; trigger != "" {
+		secret.Annotations[RotationTriggerHandledAnnotation] = trigger
+	}
+	return nil
+}
+
+// IsPreviousTokenExpired returns true when secret has a previous token, which overlap period has passed
+func IsPreviousTokenExpired(secret *corev1.Secret, key string, now time.Time) bool {
+	if _, found := secret.Data[key+PreviousTokenKeySuffix]; !found {
+		return false
+	}
+	expires, err := time.Parse(time.RFC3339, secret.Annotations[PreviousTokenExpiresAnnotation])
+	return err != nil || !now.Before(expires)
+}
+
+// RetirePreviousToken removes the previous token, after which only the current token is valid
+func RetirePreviousToken(secret *corev1.Secret, key string) {
+	delete(secret.Data, key+PreviousTokenKeySuffix)
+	delete(secret.Annotations, PreviousTokenExpiresAnnotation)
+}
+
+/*
+GetNextTokenRotationCheck returns time after which token secret should be checked again, for scheduled rotation or
+retirement of the previous token. Returns 0 if there is nothing scheduled.
+*/
+func GetNextTokenRotationCheck(instance *operatorv1alpha1.IBMLicensing, secret *corev1.Secret, key string, now time.Time) time.Duration {
+	var next time.Duration
+	schedule := func(at time.Time) {
+		after := max(at.Sub(now), time.Second)
+		if next == 0 || after < next {
+			next = after
+		}
+	}
+	if interval := instance.Spec.GetTokenRotationInterval(); interval > 0 {
+		schedule(getTokenRotatedAt(secret).Add(interval))
+	}
+	if _, found := secret.Data[key+PreviousTokenKeySuffix]; found {
+		if expires, err := time.Parse(time.RFC3339, secret.Annotations[PreviousTokenExpiresAnnotation]); err == nil {
+			schedule(expires)
+		}
+	}
+	return next
+}
+
+// Returns time of the last rotation, or creation of the secret if it was never rotated
+func getTokenRotatedAt(secret *corev1.Secret) time.Time {
+	if rotatedAt, err := time.Parse(time.RFC3339, secret.Annotations[TokenRotatedAtAnnotation]); err == nil {
+		return rotatedAt
+	}
+	return secret.CreationTimestamp.Time
+}
@@ -0,0 +1,78 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+func TestTokenRotation(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	instance := &operatorv1alpha1.IBMLicensing{}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+		Data:       map[string][]byte{APISecretTokenKeyName: []byte("old-token")},
+	}
+
+	assert.False(t, IsTokenRotationDue(instance, secret, created.Add(1000*time.Hour)), "Rotation should be disabled without tokenRotation")
+
+	instance.Spec.TokenRotation = &operatorv1alpha1.IBMLicensingTokenRotation{
+		Interval:      &metav1.Duration{Duration: 720 * time.Hour},
+		OverlapPeriod: &metav1.Duration{Duration: time.Hour},
+	}
+	assert.False(t, IsTokenRotationDue(instance, secret, created.Add(719*time.Hour)))
+	assert.Equal(t, time.Hour, GetNextTokenRotationCheck(instance, secret, APISecretTokenKeyName, created.Add(719*time.Hour)))
+	assert.True(t, IsTokenRotationDue(instance, secret, created.Add(720*time.Hour)), "Rotation should be due after the interval")
+
+	rotatedAt := created.Add(720 * time.Hour)
+	assert.NoError(t, RotateToken(instance, secret, APISecretTokenKeyName, rotatedAt))
+	assert.Equal(t, "old-token", string(secret.Data[APISecretTokenKeyName+PreviousTokenKeySuffix]), "Previous token should stay valid")
+	assert.NotEqual(t, "old-token", string(secret.Data[APISecretTokenKeyName]))
+	assert.False(t, IsTokenRotationDue(instance, secret, rotatedAt.Add(time.Minute)), "Interval should count from the last rotation")
+	assert.Equal(t, time.Hour, GetNextTokenRotationCheck(instance, secret, APISecretTokenKeyName, rotatedAt), "Retirement should be checked after overlap")
+
+	assert.False(t, IsPreviousTokenExpired(secret, APISecretTokenKeyName, rotatedAt.Add(59*time.Minute)))
+	assert.True(t, IsPreviousTokenExpired(secret, APISecretTokenKeyName, rotatedAt.Add(time.Hour)))
+	RetirePreviousToken(secret, APISecretTokenKeyName)
+	assert.NotContains(t, secret.Data, APISecretTokenKeyName+PreviousTokenKeySuffix)
+	assert.False(t, IsPreviousTokenExpired(secret, APISecretTokenKeyName, rotatedAt.Add(2*time.Hour)))
+}
+
+func TestTokenRotationTrigger(t *testing.T) {
+	instance := &operatorv1alpha1.IBMLicensing{}
+	instance.Spec.TokenRotation = &operatorv1alpha1.IBMLicensingTokenRotation{}
+	secret := &corev1.Secret{Data: map[string][]byte{APIUploadTokenKeyName: []byte("old-token")}}
+	now := time.Now()
+
+	assert.False(t, IsTokenRotationDue(instance, secret, now), "Without interval tokens should be rotated only on demand")
+	assert.Zero(t, GetNextTokenRotationCheck(instance, secret, APIUploadTokenKeyName, now))
+
+	instance.Annotations = map[string]string{RotateTokensAnnotation: "1"}
+	assert.True(t, IsTokenRotationDue(instance, secret, now), "New annotation value should trigger rotation")
+	assert.NoError(t, RotateToken(instance, secret, APIUploadTokenKeyName, now))
+	assert.False(t, IsTokenRotationDue(instance, secret, now), "Handled trigger should not rotate again")
+
+	instance.Annotations[RotateTokensAnnotation] = "2"
+	assert.True(t, IsTokenRotationDue(instance, secret, now))
+}
@@ -33,6 +33,9 @@ const ReporterHTTPSCertsVolumeName = "reporter-https-certs"
 const EmptyDirVolumeName = "tmp"
 const ReporterTokenVolumeName = "reporter-token"
 const SoftwareCentralEntitlementKeyVolumeName = "swc-entitlement-key"
+const ConsumerTokensVolumeName = "consumer-tokens"
+const AcceptedTokensVolumeName = "accepted-tokens"
+const TrustedCABundleVolumeName = "trusted-ca-bundle"
 
 var emptyDirSizeLimit600Mi, _ = resource.ParseQuantity("600Mi")
 
@@ -118,6 +121,41 @@ func getLicensingVolumeMounts(spec operatorv1alpha1.IBMLicensingSpec) []corev1.V
 		}...)
 	}
 
+	// volume mount for API tokens issued for consumer namespaces, without subPath so that issued and revoked tokens are
+	// propagated to the running pod
+	if spec.ScopedAPITokens {
+		volumeMounts = append(volumeMounts, []corev1.VolumeMount{
+			{
+				Name:      ConsumerTokensVolumeName,
+				MountPath: "/opt/ibm/licensing/consumer-tokens",
+				ReadOnly:  true,
+			},
+		}...)
+	}
+
+	// volume mount for current and previous tokens during rotation overlap, without subPath so that rotated tokens are
+	// accepted by the running pod
+	if spec.IsTokenRotationEnabled() {
+		volumeMounts = append(volumeMounts, []corev1.VolumeMount{
+			{
+				Name:      AcceptedTokensVolumeName,
+				MountPath: "/opt/ibm/licensing/accepted-tokens",
+				ReadOnly:  true,
+			},
+		}...)
+	}
+
+	// volume mount for additional trusted certificates, without subPath so that bundle updates are propagated to the running pod
+	if spec.OutboundNetwork != nil && spec.OutboundNetwork.TrustedCABundle != "" {
+		volumeMounts = append(volumeMounts, []corev1.VolumeMount{
+			{
+				Name:      TrustedCABundleVolumeName,
+				MountPath: TrustedCABundleMountPath,
+				ReadOnly:  true,
+			},
+		}...)
+	}
+
 	return volumeMounts
 }
 
@@ -219,5 +257,59 @@ func getLicensingVolumes(spec operatorv1alpha1.IBMLicensingSpec) []corev1.Volume
 		})
 	}
 
+	// create volume containing API tokens issued for consumer namespaces
+	if spec.ScopedAPITokens {
+		volumes = append(volumes, corev1.Volume{
+			Name: ConsumerTokensVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  ConsumerTokensSecretName,
+					DefaultMode: &resources.DefaultSecretMode,
+				},
+			},
+		})
+	}
+
+	// create volume containing current and previous tokens, previous ones are present only during rotation overlap
+	if spec.IsTokenRotationEnabled() {
+		tokenProjection := func(secretName, key string) corev1.VolumeProjection {
+			return corev1.VolumeProjection{
+				Secret: &corev1.SecretProjection{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Items: []corev1.KeyToPath{
+						{Key: key, Path: key},
+						{Key: key + PreviousTokenKeySuffix, Path: key + PreviousTokenKeySuffix},
+					},
+					Optional: &resources.TrueVar,
+				},
+			}
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name: AcceptedTokensVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{
+						tokenProjection(spec.GetAPISecretTokenName(), APISecretTokenKeyName),
+						tokenProjection(APIUploadTokenName, APIUploadTokenKeyName),
+					},
+					DefaultMode: &resources.DefaultSecretMode,
+				},
+			},
+		})
+	}
+
+	// create volume containing additional trusted certificates for outbound connections
+	if spec.OutboundNetwork != nil && spec.OutboundNetwork.TrustedCABundle != "" {
+		volumes = append(volumes, corev1.Volume{
+			Name: TrustedCABundleVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: spec.OutboundNetwork.TrustedCABundle},
+					Items:                []corev1.KeyToPath{{Key: TrustedCABundleKey, Path: TrustedCABundleKey}},
+				},
+			},
+		})
+	}
+
 	return volumes
 }
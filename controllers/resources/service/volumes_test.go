@@ -208,3 +208,43 @@ func TestGetLicensingVolumesSoftwareCentralEnabled(t *testing.T) {
 	assert.Equal(t, "my-entitlement-secret", swcVolume.Secret.SecretName,
 		"Software Central entitlement key volume should reference the configured secret name.")
 }
+
+// verifies that scoped API tokens are mounted as a directory, so that the pod sees issued and revoked tokens without restart.
+func TestGetLicensingVolumesScopedAPITokens(t *testing.T) {
+	spec := operatorv1alpha1.IBMLicensingSpec{
+		InstanceNamespace: "namespace",
+		Datasource:        "datacollector",
+	}
+	spec.ScopedAPITokens = true
+
+	volumeMounts := getLicensingVolumeMounts(spec)
+	assert.Equal(t, 4, len(volumeMounts), "Scoped API tokens are enabled, 4 volume mounts should be created (3 base + consumer tokens).")
+	assert.Equal(t, ConsumerTokensVolumeName, volumeMounts[3].Name)
+	assert.Empty(t, volumeMounts[3].SubPath, "Consumer tokens should not be mounted with subPath, which prevents updates.")
+
+	volumes := getLicensingVolumes(spec)
+	assert.Equal(t, 4, len(volumes), "Scoped API tokens are enabled, 4 volumes should be created (3 base + consumer tokens).")
+	assert.Equal(t, ConsumerTokensSecretName, volumes[3].Secret.SecretName)
+}
+
+// verifies that with token rotation both current and previous tokens are projected into a directory updated without restart.
+func TestGetLicensingVolumesTokenRotation(t *testing.T) {
+	spec := operatorv1alpha1.IBMLicensingSpec{
+		InstanceNamespace: "namespace",
+		Datasource:        "datacollector",
+	}
+	spec.TokenRotation = &operatorv1alpha1.IBMLicensingTokenRotation{}
+
+	volumeMounts := getLicensingVolumeMounts(spec)
+	assert.Equal(t, 4, len(volumeMounts), "Token rotation is enabled, 4 volume mounts should be created (3 base + accepted tokens).")
+	assert.Equal(t, AcceptedTokensVolumeName, volumeMounts[3].Name)
+	assert.Empty(t, volumeMounts[3].SubPath, "Accepted tokens should not be mounted with subPath, which prevents updates.")
+
+	volumes := getLicensingVolumes(spec)
+	assert.Equal(t, 4, len(volumes), "Token rotation is enabled, 4 volumes should be created (3 base + accepted tokens).")
+	sources := volumes[3].Projected.Sources
+	assert.Equal(t, 2, len(sources), "Both API and upload tokens should be projected.")
+	assert.Equal(t, "ibm-licensing-token", sources[0].Secret.Name)
+	assert.Equal(t, APIUploadTokenName, sources[1].Secret.Name)
+	assert.True(t, *sources[0].Secret.Optional, "Previous token key exists only during overlap, so it must be optional.")
+}
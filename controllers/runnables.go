@@ -0,0 +1,85 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+/*
+runnableGroup runs runnables, which are started and stopped at runtime, f.e. controllers depending on an optional API.
+The group is added to the manager, so that the manager waits for the runnables to stop on shutdown. Errors returned
+by the runnables are logged and do not stop the other ones.
+*/
+type runnableGroup struct {
+	Log logr.Logger
+
+	mutex  sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Start waits until ctx is cancelled and then stops the runnables, it implements manager.Runnable
+func (g *runnableGroup) Start(ctx context.Context) error {
+	<-ctx.Done()
+	g.Stop()
+	return nil
+}
+
+// IsRunning returns true if the runnables were started and not stopped yet
+func (g *runnableGroup) IsRunning() bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.cancel != nil
+}
+
+// Run starts the runnables with context derived from parent, they are stopped by Stop or when parent is cancelled
+func (g *runnableGroup) Run(parent context.Context, runnables ...manager.Runnable) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if g.cancel != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(parent)
+	g.cancel = cancel
+	for _, runnable := range runnables {
+		g.wg.Add(1)
+		go func() {
+			defer g.wg.Done()
+			if err := runnable.Start(ctx); err != nil {
+				g.Log.Error(err, "Background task stopped with an error", "task", fmt.Sprintf("%T", runnable))
+			}
+		}()
+	}
+}
+
+// Stop cancels the runnables and waits until all of them return
+func (g *runnableGroup) Stop() {
+	g.mutex.Lock()
+	cancel := g.cancel
+	g.cancel = nil
+	g.mutex.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+	g.wg.Wait()
+}
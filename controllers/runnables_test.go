@@ -0,0 +1,70 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+)
+
+var _ = Describe("Background tasks", func() {
+	It("Should stop runnables of the group and wait for them", func(ctx SpecContext) {
+		group := runnableGroup{Log: logr.Discard()}
+		stopped := make(chan struct{}, 2)
+		blocking := manager.RunnableFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			stopped <- struct{}{}
+			return nil
+		})
+		failing := manager.RunnableFunc(func(context.Context) error {
+			stopped <- struct{}{}
+			return errors.New("failed")
+		})
+
+		group.Run(ctx, blocking, failing)
+		Expect(group.IsRunning()).To(BeTrue())
+		Eventually(stopped).Should(Receive())
+		Consistently(stopped, 100*time.Millisecond).ShouldNot(Receive())
+
+		group.Stop()
+		Expect(group.IsRunning()).To(BeFalse())
+		Expect(stopped).To(Receive())
+	})
+
+	It("Should stop the OperatorGroup coordinator and unregister its heartbeat", func(ctx SpecContext) {
+		heartbeats := res.NewHeartbeats()
+		coordinator := &OperatorGroupCoordinator{Log: logr.Discard(), Heartbeats: heartbeats}
+		Expect(coordinator.NeedLeaderElection()).To(BeTrue())
+
+		coordinatorCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() {
+			done <- coordinator.Start(coordinatorCtx)
+		}()
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(heartbeats.Check(nil)).To(Succeed())
+	})
+})
@@ -35,6 +35,7 @@ import (
 	"go.uber.org/zap/zapcore"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -51,6 +52,7 @@ import (
 	odlm "github.com/IBM/operand-deployment-lifecycle-manager/api/v1alpha1"
 
 	operatoribmcomv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -221,16 +223,20 @@ var _ = BeforeSuite(func() {
 	k8sCFromMgr = mgr.GetClient()
 	k8sRFromMgr = mgr.GetAPIReader()
 
-	nssEnabledSemaphore := make(chan bool, 1)
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	Expect(err).ToNot(HaveOccurred())
+	capabilities := res.NewClusterCapabilities(discoveryClient, ctrl.Log.WithName("capabilities"))
+	_, err = capabilities.Refresh(context.Background())
+	Expect(err).ToNot(HaveOccurred())
 
 	err = (&IBMLicensingReconciler{
-		Client:                  mgr.GetClient(),
-		Reader:                  mgr.GetAPIReader(),
-		Log:                     ctrl.Log.WithName("controllers").WithName("IBMLicensing"),
-		Scheme:                  mgr.GetScheme(),
-		Recorder:                mgr.GetEventRecorderFor("IBMLicensing"),
-		OperatorNamespace:       operatorNamespace,
-		NamespaceScopeSemaphore: nssEnabledSemaphore,
+		Client:            mgr.GetClient(),
+		Reader:            mgr.GetAPIReader(),
+		Log:               ctrl.Log.WithName("controllers").WithName("IBMLicensing"),
+		Scheme:            mgr.GetScheme(),
+		Recorder:          mgr.GetEventRecorderFor("IBMLicensing"),
+		OperatorNamespace: operatorNamespace,
+		Capabilities:      capabilities,
 	}).SetupWithManager(mgr)
 	Expect(err).ToNot(HaveOccurred())
 
@@ -22,7 +22,9 @@ import (
 	"fmt"
 	"os"
 	r "runtime"
+	"time"
 
+	configv1 "github.com/openshift/api/config/v1"
 	servicecav1 "github.com/openshift/api/operator/v1"
 	routev1 "github.com/openshift/api/route/v1"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -38,16 +40,19 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/discovery"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	operatorframeworkv1 "github.com/operator-framework/api/pkg/operators/v1"
+	operatorframeworkv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 
 	odlm "github.com/IBM/operand-deployment-lifecycle-manager/api/v1alpha1"
 
@@ -105,18 +110,29 @@ func init() {
 
 	utilruntime.Must(operatorframeworkv1.AddToScheme(scheme))
 
+	utilruntime.Must(operatorframeworkv1alpha1.AddToScheme(scheme))
+
+	utilruntime.Must(configv1.AddToScheme(scheme))
+
 	// +kubebuilder:scaffold:scheme
 }
 
 func main() {
-	var metricsAddr string
-	var enableLeaderElection bool
-	var routinesToCancel []func()
-	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
-	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
-		"Enable leader election for controller manager. "+
-			"Enabling this will ensure there is only one active controller manager.")
+	if len(os.Args) > 1 && os.Args[1] == mustGatherCommand {
+		if err := runMustGather(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	var configConfigMap string
+	var configFlags operatorConfigFlags
+	flag.StringVar(&configConfigMap, "config-configmap", res.OperatorConfigConfigMapName,
+		"Name of the ConfigMap in operator namespace containing the operator configuration under the "+res.OperatorConfigKey+" key.")
+	configFlags.register(flag.CommandLine)
 	flag.Parse()
+	configFlags.visit(flag.CommandLine)
 
 	ctrl.SetLogger(zap.New(func(o *zap.Options) {
 		o.Development = true
@@ -137,21 +153,45 @@ func main() {
 		watchNamespaces = []string{operatorNamespace}
 	}
 
-	licensingLabelSelector, _ := labels.Parse("release in (ibm-licensing-service)")
+	restConfig := ctrl.GetConfigOrDie()
+	// Configuration is read before the manager is created, as it contains manager and cache options
+	configReader, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		setupLog.Error(err, "unable to create client for reading operator configuration")
+		os.Exit(1)
+	}
+	if _, err := res.GetCrdReconcileInterval(); err != nil {
+		setupLog.Error(err, "Incorrect reconcile interval set. Defaulting to 300s", "controller", "capabilities")
+	}
+	operatorConfig, err := res.GetOperatorConfig(context.Background(), configReader, operatorNamespace, configConfigMap, configFlags.apply)
+	if err != nil {
+		setupLog.Error(err, "unable to read operator configuration")
+		os.Exit(1)
+	}
+	if err := operatorConfig.ApplyLicensingImage(); err != nil {
+		setupLog.Error(err, "unable to apply License Service image from operator configuration")
+		os.Exit(1)
+	}
+	operatorConfigStore := res.NewOperatorConfigStore(operatorConfig)
+
+	// Validated together with the configuration
+	licensingLabelSelector, _ := labels.Parse(operatorConfig.CacheLabelSelector)
 
 	byObject := map[client.Object]cache.ByObject{
 		&corev1.Secret{}:     {Label: licensingLabelSelector},
 		&appsv1.Deployment{}: {Label: licensingLabelSelector},
 		&corev1.Pod{}:        {Label: licensingLabelSelector},
+		// IBMLicensingAccessRequests are created in consumer namespaces, which are not watched on clusters without ODLM
+		&operatoribmcomv1alpha1.IBMLicensingAccessRequest{}: {Namespaces: map[string]cache.Config{cache.AllNamespaces: {}}},
 	}
 
-	restConfig := ctrl.GetConfigOrDie()
-	probeClient, err := client.New(restConfig, client.Options{Scheme: scheme})
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
 	if err != nil {
-		setupLog.Error(err, "unable to create probe client for CRD discovery")
+		setupLog.Error(err, "unable to create discovery client for cluster capabilities detection")
 		os.Exit(1)
 	}
-	if err := res.UpdateCacheClusterExtensions(probeClient, setupLog); err != nil {
+	capabilities := res.NewClusterCapabilities(discoveryClient, ctrl.Log.WithName("capabilities"))
+	if _, err := capabilities.Refresh(context.Background()); err != nil {
 		setupLog.Error(err, "Error during checking K8s API")
 		os.Exit(1)
 	}
@@ -160,11 +200,11 @@ func main() {
 	// in its own namespace and should only be cached there
 	operatorNamespaceOnly := map[string]cache.Config{operatorNamespace: {}}
 
-	if res.IsGatewayAPI {
+	if capabilities.IsGatewayAPI() {
 		byObject[&gatewayv1.Gateway{}] = cache.ByObject{Namespaces: operatorNamespaceOnly}
 		byObject[&gatewayv1.HTTPRoute{}] = cache.ByObject{Namespaces: operatorNamespaceOnly}
 	}
-	if res.IsBackendTLSPolicyAPI {
+	if capabilities.IsBackendTLSPolicyAPI() {
 		byObject[&gatewayv1.BackendTLSPolicy{}] = cache.ByObject{Namespaces: operatorNamespaceOnly}
 	}
 
@@ -176,11 +216,18 @@ func main() {
 	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		Scheme: scheme,
 		Metrics: metricsserver.Options{
-			BindAddress: metricsAddr,
+			BindAddress: operatorConfig.MetricsBindAddress,
 		},
-		WebhookServer:    webhook.NewServer(webhook.Options{Port: 9443}),
-		LeaderElection:   enableLeaderElection,
-		LeaderElectionID: "e1f51baf.ibm.com",
+		HealthProbeBindAddress:  operatorConfig.HealthProbeBindAddress,
+		WebhookServer:           webhook.NewServer(webhook.Options{Port: 9443}),
+		LeaderElection:          *operatorConfig.LeaderElection.Enabled,
+		LeaderElectionID:        res.LeaderElectionID,
+		LeaderElectionNamespace: operatorNamespace,
+		LeaseDuration:           &operatorConfig.LeaderElection.LeaseDuration.Duration,
+		RenewDeadline:           &operatorConfig.LeaderElection.RenewDeadline.Duration,
+		RetryPeriod:             &operatorConfig.LeaderElection.RetryPeriod.Duration,
+		// Standby replica takes over right after the leader is stopped, as the process exits once the manager is stopped
+		LeaderElectionReleaseOnCancel: true,
 		Cache: cache.Options{
 			DefaultNamespaces: defaultNamespaces,
 			ByObject:          byObject,
@@ -191,108 +238,139 @@ func main() {
 		os.Exit(1)
 	}
 
-	// 1-size channel for communicating namespace scope status between IBMLicensing controller and operandrequest-discovery goroutine
-	nssEnabledSemaphore := make(chan bool, 1)
+	// Progress of background tasks reported for the liveness check
+	heartbeats := res.NewHeartbeats()
+	if err = controllers.AddHealthChecks(mgr, operatorNamespace, capabilities, heartbeats); err != nil {
+		setupLog.Error(err, "unable to set up health checks")
+		os.Exit(1)
+	}
 
 	controller := &controllers.IBMLicensingReconciler{
-		Client:                  mgr.GetClient(),
-		Reader:                  mgr.GetAPIReader(),
-		Log:                     ctrl.Log.WithName("controllers").WithName("IBMLicensing"),
-		Scheme:                  mgr.GetScheme(),
-		Recorder:                mgr.GetEventRecorderFor("IBMLicensing"),
-		OperatorNamespace:       operatorNamespace,
-		NamespaceScopeSemaphore: nssEnabledSemaphore,
+		Client:            mgr.GetClient(),
+		Reader:            mgr.GetAPIReader(),
+		Log:               ctrl.Log.WithName("controllers").WithName("IBMLicensing"),
+		Scheme:            mgr.GetScheme(),
+		Recorder:          mgr.GetEventRecorderFor("IBMLicensing"),
+		OperatorNamespace: operatorNamespace,
+		Capabilities:      capabilities,
+		Config:            operatorConfigStore,
 	}
 	if err = controller.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMLicensing")
 		os.Exit(1)
 	}
 
-	operandRequestList := odlm.OperandRequestList{}
-	opreqControllerEnabled, err := res.DoesCRDExist(mgr.GetAPIReader(), &operandRequestList)
-	if err != nil {
-		setupLog.Error(err, "An error occurred while checking for CRD existence. OperandRequest controller will not be started")
+	// Single writer of the OperatorGroup targetNamespaces, used by operandrequest-discovery and stale namespaces task
+	operatorGroupCoordinator := &controllers.OperatorGroupCoordinator{
+		Client:            mgr.GetClient(),
+		Reader:            mgr.GetAPIReader(),
+		Log:               ctrl.Log.WithName("operatorgroup-coordinator"),
+		OperatorNamespace: operatorNamespace,
+		Config:            operatorConfigStore,
+		Heartbeats:        heartbeats,
 	}
 
-	if opreqControllerEnabled {
-		if err = (&controllers.OperandRequestReconciler{
+	// OperandRequest controllers are started by the capabilities controller once OperandRequest CRD is found on the cluster
+	if err = (&controllers.CapabilityReconciler{
+		Client:                 mgr.GetClient(),
+		Reader:                 mgr.GetAPIReader(),
+		Log:                    ctrl.Log.WithName("controllers").WithName("capabilities"),
+		OperatorNamespace:      operatorNamespace,
+		Capabilities:           capabilities,
+		Config:                 operatorConfigStore,
+		IBMLicensingReconciler: controller,
+		OperandRequestReconciler: &controllers.OperandRequestReconciler{
 			Client:            mgr.GetClient(),
 			Reader:            mgr.GetAPIReader(),
 			Log:               ctrl.Log.WithName("controllers").WithName("OperandRequest"),
 			Scheme:            mgr.GetScheme(),
 			OperatorNamespace: operatorNamespace,
-		}).SetupWithManager(mgr); err != nil {
-			setupLog.Error(err, "unable to create controller", "controller", "OperandRequest")
-			os.Exit(1)
-		}
-		crdLogger := ctrl.Log.WithName("operandrequest-discovery")
-		// In Cloud Pak 2.0/3.0 coexistence scenario, License Service Operator 4.x.x leverages Namespace Scope Operator and must not modify OperatorGroup.
-		isNssActive, err := res.IsNamespaceScopeOperatorAvailable(context.Background(), mgr.GetAPIReader(), operatorNamespace)
-		if err != nil {
-			setupLog.Error(err, "Error occurred while detecting Namespace Scope Operator")
-		}
-		if isNssActive {
-			setupLog.Info("Namespace Scope ConfigMap detected. operandrequest-discovery disabled")
-		} else {
-			// On clusters without OLM installed, skip both operandrequest-discovery and the stale-namespace cleanup task,
-			// otherwise every run produces a "no matches for kind OperatorGroup" error
-			operatorGroupList := operatorframeworkv1.OperatorGroupList{}
-			operatorGroupCRDExists, err := res.DoesCRDExist(mgr.GetAPIReader(), &operatorGroupList)
-			if err != nil {
-				setupLog.Error(err, "An error occurred while checking for OperatorGroup CRD existence. operandrequest-discovery and operatorgroup-namespaces-watcher will not be started")
-			}
-
-			if operatorGroupCRDExists {
-				go controllers.DiscoverOperandRequests(&crdLogger, mgr.GetClient(), mgr.GetAPIReader(), watchNamespaces, nssEnabledSemaphore)
-
-				logger := ctrl.Log.WithName("operatorgroup-namespaces-watcher")
-				removeStaleNamespacesTaskCtx, cancelRemoveStaleNamespacesTask := context.WithCancel(context.Background())
-
-				go controllers.RunRemoveStaleNamespacesFromOperatorGroupTask(removeStaleNamespacesTaskCtx, &logger, mgr.GetClient(), mgr.GetAPIReader())
-				routinesToCancel = append(routinesToCancel, cancelRemoveStaleNamespacesTask)
-			} else {
-				setupLog.Info("OperatorGroup CRD not found in cluster. operandrequest-discovery and operatorgroup-namespaces-watcher disabled")
-			}
-		}
-	} else {
-		logger := ctrl.Log.WithName("crd-watcher").WithName("OperandRequest")
-		// Set custom time duration for CRD watcher (in seconds)
-		reconcileInterval, err := res.GetCrdReconcileInterval()
-		if err != nil {
-			setupLog.Error(err, "Incorrect reconcile interval set. Defaulting to 300s", "crd-watcher", "OperandRequest")
-		}
-		go res.RestartOnCRDCreation(&logger, mgr.GetClient(), &operandRequestList, reconcileInterval)
+			Config:            operatorConfigStore,
+		},
+		OperandRequestDiscoveryReconciler: &controllers.OperandRequestDiscoveryReconciler{
+			Client:                   mgr.GetClient(),
+			Reader:                   mgr.GetAPIReader(),
+			Log:                      ctrl.Log.WithName("controllers").WithName("operandrequest-discovery"),
+			OperatorNamespace:        operatorNamespace,
+			WatchNamespaces:          watchNamespaces,
+			OperatorGroupCoordinator: operatorGroupCoordinator,
+			Config:                   operatorConfigStore,
+			Heartbeats:               heartbeats,
+		},
+		OperatorGroupCoordinator: operatorGroupCoordinator,
+		Heartbeats:               heartbeats,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "capabilities")
+		os.Exit(1)
+	}
+
+	if err = (&controllers.IBMLicensingAccessRequestReconciler{
+		Client:            mgr.GetClient(),
+		Reader:            mgr.GetAPIReader(),
+		Log:               ctrl.Log.WithName("controllers").WithName("IBMLicensingAccessRequest"),
+		Scheme:            mgr.GetScheme(),
+		OperatorNamespace: operatorNamespace,
+		Config:            operatorConfigStore,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IBMLicensingAccessRequest")
+		os.Exit(1)
 	}
 
 	// If OperandBindInfo CRD exists, try to find ibm-licensing-bindinfo and delete it.
-	operandBindInfoList := odlm.OperandBindInfoList{}
-	bindInfoCrdExists, err := res.DoesCRDExist(mgr.GetAPIReader(), &operandBindInfoList)
-	if err != nil {
-		setupLog.Error(err, "An error occurred while checking for OperandBindInfo CRD existence")
+	if err = mgr.Add(&controllers.BindInfoCleaner{
+		Client:            mgr.GetClient(),
+		Reader:            mgr.GetAPIReader(),
+		Log:               ctrl.Log.WithName("bindinfo-cleaner"),
+		OperatorNamespace: operatorNamespace,
+	}); err != nil {
+		setupLog.Error(err, "unable to add OperandBindInfo cleaner")
+		os.Exit(1)
 	}
 
-	if bindInfoCrdExists {
-		go func() {
-			err := res.DeleteBindInfoIfExists(context.TODO(), mgr.GetAPIReader(), mgr.GetClient(), operatorNamespace)
-			if err != nil {
-				ctrl.Log.Error(err, "An error occurred while detecting and deleting "+res.LsBindInfoName)
-			} else {
-				ctrl.Log.Info(res.LsBindInfoName + " deleted")
-			}
-		}()
+	// Replaces the cleanup Job of the helm-migration chart, progress is stored in ibm-licensing-olm-migration ConfigMap
+	if *operatorConfig.MigrateFromOLM {
+		if err = mgr.Add(&controllers.OLMMigrator{
+			Client:            mgr.GetClient(),
+			Reader:            mgr.GetAPIReader(),
+			Log:               ctrl.Log.WithName("olm-migration"),
+			OperatorNamespace: operatorNamespace,
+			Interval:          time.Minute,
+		}); err != nil {
+			setupLog.Error(err, "unable to add OLM migration")
+			os.Exit(1)
+		}
+	}
+
+	// Applies changes of the operator configuration ConfigMap, which are safe to be applied at runtime
+	if err = mgr.Add(&controllers.OperatorConfigWatcher{
+		Reader:                 mgr.GetAPIReader(),
+		Log:                    ctrl.Log.WithName("operator-config"),
+		OperatorNamespace:      operatorNamespace,
+		ConfigMapName:          configConfigMap,
+		Store:                  operatorConfigStore,
+		Overrides:              configFlags.apply,
+		Interval:               30 * time.Second,
+		IBMLicensingReconciler: controller,
+	}); err != nil {
+		setupLog.Error(err, "unable to add operator configuration watcher")
+		os.Exit(1)
 	}
 
 	// +kubebuilder:scaffold:builder
 
-	setupLog.Info("Creating first instance.")
-	_ = controller.CreateDefaultInstance(true)
+	// Default instance is created by the leader only, so that standby replicas do not create it concurrently
+	if err = mgr.Add(manager.RunnableFunc(func(context.Context) error {
+		setupLog.Info("Creating first instance.")
+		_ = controller.CreateDefaultInstance(true)
+		return nil
+	})); err != nil {
+		setupLog.Error(err, "unable to add creation of the default instance")
+		os.Exit(1)
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
-		for _, cancelRoutine := range routinesToCancel {
-			cancelRoutine()
-		}
 		os.Exit(1)
 	}
 }
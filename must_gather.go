@@ -0,0 +1,118 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+	"github.com/IBM/ibm-licensing-operator/pkg/mustgather"
+)
+
+// Name of the subcommand collecting diagnostics, f.e. `manager must-gather --kubeconfig ~/.kube/config`
+const mustGatherCommand = "must-gather"
+
+// Default namespace of the operator, used when OPERATOR_NAMESPACE is not set outside of the cluster
+const defaultOperatorNamespace = "ibm-licensing"
+
+func runMustGather(args []string) error {
+	flags := flag.NewFlagSet(mustGatherCommand, flag.ContinueOnError)
+	kubeconfig := flags.String("kubeconfig", "",
+		"Path to the kubeconfig file. KUBECONFIG, ~/.kube/config or in-cluster configuration is used if empty.")
+	namespace := flags.String("namespace", "",
+		"Namespace of the operator. Defaults to OPERATOR_NAMESPACE, or "+defaultOperatorNamespace+" if it is not set.")
+	output := flags.String("output", "",
+		"Path of the created tarball. Defaults to ibm-licensing-must-gather-<time>.tar.gz in the current directory.")
+	timeout := flags.Duration("timeout", 5*time.Minute, "Time limit for collecting the diagnostics.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	ctrl.SetLogger(zap.New())
+	logger := ctrl.Log.WithName(mustGatherCommand)
+
+	if *namespace == "" {
+		operatorNamespace, err := res.GetOperatorNamespace()
+		if err != nil {
+			operatorNamespace = defaultOperatorNamespace
+		}
+		*namespace = operatorNamespace
+	}
+	if *output == "" {
+		*output = fmt.Sprintf("%s-%s.tar.gz", mustgather.BundleRoot, time.Now().UTC().Format("20060102-150405"))
+	}
+
+	var restConfig *rest.Config
+	var err error
+	if *kubeconfig != "" {
+		restConfig, err = clientcmd.BuildConfigFromFlags("", *kubeconfig)
+	} else {
+		restConfig, err = ctrl.GetConfig()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	k8sClient, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	// Capabilities are collected as detected by the operator, failures are logged and the detected part is used
+	capabilities := res.NewClusterCapabilities(nil, logger)
+	if discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig); err == nil {
+		capabilities = res.NewClusterCapabilities(discoveryClient, logger)
+	} else {
+		logger.Error(err, "Unable to create discovery client, capabilities are not detected")
+	}
+	if _, err := capabilities.Refresh(ctx); err != nil {
+		logger.Error(err, "Unable to detect some of the cluster capabilities")
+	}
+
+	file, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gatherer := &mustgather.Gatherer{
+		Client:            k8sClient,
+		Capabilities:      capabilities.Get(),
+		OperatorNamespace: *namespace,
+	}
+	if err := gatherer.Gather(ctx, file); err != nil {
+		return fmt.Errorf("failed to write %s: %w", *output, err)
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	logger.Info("Diagnostics collected", "output", *output, "namespace", *namespace)
+	return nil
+}
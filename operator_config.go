@@ -0,0 +1,133 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"flag"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+)
+
+/*
+Command line flags, which were used before the operator configuration ConfigMap was introduced. They are still supported
+and override the configuration, but only if they are set explicitly.
+*/
+type operatorConfigFlags struct {
+	metricsAddr                      string
+	probeAddr                        string
+	enableLeaderElection             bool
+	createDefaultInstance            bool
+	defaultInstanceTemplateConfigMap string
+	defaultInstanceTemplateFile      string
+	bindingsConfigMap                string
+	operatorGroupDryRun              bool
+	operatorGroupAllowedNamespaces   string
+	operatorGroupDeniedNamespaces    string
+	operatorGroupUpdateInterval      time.Duration
+	migrateFromOLM                   bool
+
+	// Names of the flags set explicitly
+	set map[string]bool
+}
+
+func (f *operatorConfigFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.metricsAddr, "metrics-addr", ":8080",
+		"The address the metric endpoint binds to. Overrides metricsBindAddress of the operator configuration.")
+	flags.StringVar(&f.probeAddr, "health-probe-bind-address", ":8081",
+		"The address the probe endpoint binds to. Overrides healthProbeBindAddress of the operator configuration.")
+	flags.BoolVar(&f.enableLeaderElection, "enable-leader-election", true,
+		"Enable leader election for controller manager. "+
+			"Enabling this will ensure there is only one active controller manager. Overrides leaderElection.enabled of the operator configuration.")
+	flags.BoolVar(&f.createDefaultInstance, "create-default-instance", true,
+		"Create the default IBMLicensing instance at startup and whenever all instances are deleted. Overrides defaultInstance.create of the operator configuration.")
+	flags.StringVar(&f.defaultInstanceTemplateConfigMap, "default-instance-configmap", "",
+		"Name of the ConfigMap in operator namespace containing the default IBMLicensing instance template under the instance.yaml key. "+
+			"Overrides defaultInstance.templateConfigMap of the operator configuration.")
+	flags.StringVar(&f.defaultInstanceTemplateFile, "default-instance-template", "",
+		"Path to the YAML file containing the default IBMLicensing instance template. Ignored if the template ConfigMap is set. "+
+			"Overrides defaultInstance.templateFile of the operator configuration.")
+	flags.StringVar(&f.bindingsConfigMap, "bindings-configmap", "",
+		"Name of the ConfigMap in operator namespace containing additional OperandRequest bindings under the bindings.yaml key. "+
+			"Overrides bindingsConfigMap of the operator configuration.")
+	flags.BoolVar(&f.operatorGroupDryRun, "operatorgroup-dry-run", false,
+		"Only record intended changes of the licensing OperatorGroup targetNamespaces in the audit ConfigMap, without modifying it. "+
+			"Overrides operatorGroup.dryRun of the operator configuration.")
+	flags.StringVar(&f.operatorGroupAllowedNamespaces, "operatorgroup-allowed-namespaces", "",
+		"Comma-separated namespace patterns (f.e. team-*), which may be added to the licensing OperatorGroup. All namespaces are allowed if empty. "+
+			"Overrides operatorGroup.allowedNamespaces of the operator configuration.")
+	flags.StringVar(&f.operatorGroupDeniedNamespaces, "operatorgroup-denied-namespaces", "",
+		"Comma-separated namespace patterns, which are never added to the licensing OperatorGroup. "+
+			"Overrides operatorGroup.deniedNamespaces of the operator configuration.")
+	flags.DurationVar(&f.operatorGroupUpdateInterval, "operatorgroup-update-interval", time.Minute,
+		"Minimal time between updates of the licensing OperatorGroup. Changes requested in the meantime are applied together, as every update restarts the operator. "+
+			"Overrides operatorGroup.updateInterval of the operator configuration.")
+	flags.BoolVar(&f.migrateFromOLM, "migrate-from-olm", true,
+		"When the operator is deployed by Helm, remove Subscriptions, ClusterServiceVersions and other leftovers of the OLM-based deployment. "+
+			"Overrides migrateFromOLM of the operator configuration.")
+}
+
+// Remembers flags set explicitly, must be called after the flags are parsed
+func (f *operatorConfigFlags) visit(flags *flag.FlagSet) {
+	f.set = map[string]bool{}
+	flags.Visit(func(setFlag *flag.Flag) {
+		f.set[setFlag.Name] = true
+	})
+}
+
+// Overrides the operator configuration with flags set explicitly
+func (f *operatorConfigFlags) apply(config *res.OperatorConfig) {
+	if f.set["metrics-addr"] {
+		config.MetricsBindAddress = f.metricsAddr
+	}
+	if f.set["health-probe-bind-address"] {
+		config.HealthProbeBindAddress = f.probeAddr
+	}
+	if f.set["enable-leader-election"] {
+		config.LeaderElection.Enabled = ptr.To(f.enableLeaderElection)
+	}
+	if f.set["create-default-instance"] {
+		config.DefaultInstance.Create = ptr.To(f.createDefaultInstance)
+	}
+	if f.set["default-instance-configmap"] {
+		config.DefaultInstance.TemplateConfigMap = f.defaultInstanceTemplateConfigMap
+	}
+	if f.set["default-instance-template"] {
+		config.DefaultInstance.TemplateFile = f.defaultInstanceTemplateFile
+	}
+	if f.set["bindings-configmap"] {
+		config.BindingsConfigMap = f.bindingsConfigMap
+	}
+	if f.set["operatorgroup-dry-run"] {
+		config.OperatorGroup.DryRun = f.operatorGroupDryRun
+	}
+	if f.set["operatorgroup-allowed-namespaces"] {
+		config.OperatorGroup.AllowedNamespaces = res.SplitList(f.operatorGroupAllowedNamespaces)
+	}
+	if f.set["operatorgroup-denied-namespaces"] {
+		config.OperatorGroup.DeniedNamespaces = res.SplitList(f.operatorGroupDeniedNamespaces)
+	}
+	if f.set["operatorgroup-update-interval"] {
+		config.OperatorGroup.UpdateInterval = &metav1.Duration{Duration: f.operatorGroupUpdateInterval}
+	}
+	if f.set["migrate-from-olm"] {
+		config.MigrateFromOLM = ptr.To(f.migrateFromOLM)
+	}
+}
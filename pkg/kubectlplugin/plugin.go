@@ -0,0 +1,309 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+/*
+Package kubectlplugin implements operations of the kubectl-ibm-licensing plugin on IBMLicensing instances, using the
+same helpers as the operator, so that names of resources and annotations stay consistent with the operator.
+*/
+package kubectlplugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources/service"
+)
+
+// Plugin runs operations on an IBMLicensing instance and writes their output to Out
+type Plugin struct {
+	Client client.Client
+	Out    io.Writer
+	// Namespace of the operator, used for instances without instanceNamespace
+	OperatorNamespace string
+	// Name of the IBMLicensing instance, the active instance is used if empty
+	InstanceName string
+}
+
+/*
+Returns the IBMLicensing instance selected by name, or the active one. The instance namespace is defaulted to the
+operator namespace in the same way as by the operator.
+*/
+func (p *Plugin) getInstance(ctx context.Context) (*operatorv1alpha1.IBMLicensing, error) {
+	instance := &operatorv1alpha1.IBMLicensing{}
+	if p.InstanceName != "" {
+		if err := p.Client.Get(ctx, types.NamespacedName{Name: p.InstanceName}, instance); err != nil {
+			return nil, err
+		}
+	} else {
+		instanceList := operatorv1alpha1.IBMLicensingList{}
+		if err := p.Client.List(ctx, &instanceList); err != nil {
+			return nil, err
+		}
+		found := false
+		for i := range instanceList.Items {
+			if instanceList.Items[i].Status.State == service.ActiveCRState || len(instanceList.Items) == 1 {
+				instance = &instanceList.Items[i]
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New("no active IBMLicensing instance found, select one with --instance")
+		}
+	}
+	if instance.Spec.InstanceNamespace == "" {
+		instance.Spec.InstanceNamespace = p.OperatorNamespace
+	}
+	return instance, nil
+}
+
+// Status prints state, version, pods and conditions of the instance
+func (p *Plugin) Status(ctx context.Context) error {
+	instance, err := p.getInstance(ctx)
+	if err != nil {
+		return err
+	}
+	writer := tabwriter.NewWriter(p.Out, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(writer, "Name:\t%s\n", instance.Name)
+	fmt.Fprintf(writer, "State:\t%s\n", instance.Status.State)
+	fmt.Fprintf(writer, "Version:\t%s\n", instance.Spec.Version)
+	fmt.Fprintf(writer, "Instance namespace:\t%s\n", instance.Spec.InstanceNamespace)
+	fmt.Fprintf(writer, "Paused:\t%t\n", service.IsReconciliationPaused(instance))
+	if instance.Status.ClusterID != "" {
+		fmt.Fprintf(writer, "Cluster ID:\t%s\n", instance.Status.ClusterID)
+	}
+	fmt.Fprintf(writer, "Pods:\t%d\n", len(instance.Status.LicensingPods))
+	for _, pod := range instance.Status.LicensingPods {
+		ready := 0
+		for _, containerStatus := range pod.ContainerStatuses {
+			if containerStatus.Ready {
+				ready++
+			}
+		}
+		fmt.Fprintf(writer, "  %s\t%s, %d/%d containers ready\n", pod.PodIP, pod.Phase, ready, len(pod.ContainerStatuses))
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	if len(instance.Status.Conditions) == 0 {
+		return nil
+	}
+	fmt.Fprintln(p.Out, "\nConditions:")
+	writer = tabwriter.NewWriter(p.Out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(writer, "TYPE\tSTATUS\tREASON\tAGE\tMESSAGE")
+	for _, condition := range instance.Status.Conditions {
+		age := time.Since(condition.LastTransitionTime.Time).Round(time.Second)
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", condition.Type, condition.Status, condition.Reason, age, condition.Message)
+	}
+	return writer.Flush()
+}
+
+// URL prints the in-cluster URL of License Service API, together with the route or gateway URL if it is exposed
+func (p *Plugin) URL(ctx context.Context) error {
+	instance, err := p.getInstance(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(p.Out, "Service:\t%s\n", service.GetServiceURL(instance))
+
+	route := &routev1.Route{}
+	err = p.Client.Get(ctx, types.NamespacedName{Namespace: instance.Spec.InstanceNamespace, Name: service.GetResourceName(instance)}, route)
+	if err == nil && route.Spec.Host != "" {
+		fmt.Fprintf(p.Out, "Route:\thttps://%s\n", route.Spec.Host)
+	} else if err != nil && !isNotFound(err) {
+		return err
+	}
+
+	gateway := &gatewayv1.Gateway{}
+	err = p.Client.Get(ctx, types.NamespacedName{Namespace: instance.Spec.InstanceNamespace, Name: service.GatewayName}, gateway)
+	if err == nil {
+		if gatewayURL := getGatewayURL(instance, gateway); gatewayURL != "" {
+			fmt.Fprintf(p.Out, "Gateway:\t%s\n", gatewayURL)
+		}
+	} else if !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// Returns URL of License Service exposed by the gateway, or empty string if the gateway has no address yet
+func getGatewayURL(instance *operatorv1alpha1.IBMLicensing, gateway *gatewayv1.Gateway) string {
+	if len(gateway.Status.Addresses) == 0 {
+		return ""
+	}
+	host := gateway.Status.Addresses[0].Value
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.Protocol == gatewayv1.HTTPSProtocolType && listener.Port != 443 {
+			host = fmt.Sprintf("%s:%d", host, listener.Port)
+			break
+		}
+	}
+	return "https://" + host + "/" + service.GetResourceName(instance)
+}
+
+// Token prints License Service API token from the apiSecretToken secret
+func (p *Plugin) Token(ctx context.Context) error {
+	instance, err := p.getInstance(ctx)
+	if err != nil {
+		return err
+	}
+	secret := &corev1.Secret{}
+	secretName := instance.Spec.GetAPISecretTokenName()
+	if err := p.Client.Get(ctx, types.NamespacedName{Namespace: instance.Spec.InstanceNamespace, Name: secretName}, secret); err != nil {
+		return err
+	}
+	token, found := secret.Data[service.APISecretTokenKeyName]
+	if !found {
+		return fmt.Errorf("secret %s does not contain %s key", secretName, service.APISecretTokenKeyName)
+	}
+	fmt.Fprintln(p.Out, string(token))
+	return nil
+}
+
+// RotateTokens triggers rotation of API and upload tokens by a new value of the rotation annotation
+func (p *Plugin) RotateTokens(ctx context.Context) error {
+	instance, err := p.getInstance(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkNotPaused(instance); err != nil {
+		return err
+	}
+	if !instance.Spec.IsTokenRotationEnabled() {
+		return fmt.Errorf("token rotation is not enabled, set spec.tokenRotation of IBMLicensing %s", instance.Name)
+	}
+	if err := p.annotate(ctx, instance, service.RotateTokensAnnotation, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return err
+	}
+	fmt.Fprintf(p.Out, "Rotation of tokens triggered, previous tokens are valid for %s\n", instance.Spec.GetTokenOverlapPeriod())
+	return nil
+}
+
+/*
+RotateCertificates deletes self-signed and OpenShift service CA certificates of the instance, so that they are
+generated again, and restarts License Service. Custom certificates are never deleted.
+*/
+func (p *Plugin) RotateCertificates(ctx context.Context) error {
+	instance, err := p.getInstance(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkNotPaused(instance); err != nil {
+		return err
+	}
+	secretNames := []string{service.LicenseServiceInternalCertName, service.PrometheusServiceOCPCertName}
+	if instance.Spec.HTTPSCertsSource != operatorv1alpha1.CustomCertsSource {
+		secretNames = append(secretNames, service.LicenseServiceExternalCertName)
+	}
+	var deleted []string
+	for _, secretName := range secretNames {
+		secret := &corev1.Secret{}
+		secret.Name = secretName
+		secret.Namespace = instance.Spec.InstanceNamespace
+		if err := p.Client.Delete(ctx, secret); err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return err
+		}
+		deleted = append(deleted, secretName)
+	}
+	if len(deleted) == 0 {
+		return errors.New("no generated certificates found")
+	}
+	fmt.Fprintf(p.Out, "Deleted certificates %s, they will be generated again\n", strings.Join(deleted, ", "))
+	return p.Restart(ctx)
+}
+
+// Restart performs rolling restart of License Service deployment
+func (p *Plugin) Restart(ctx context.Context) error {
+	instance, err := p.getInstance(ctx)
+	if err != nil {
+		return err
+	}
+	deploymentNsName := types.NamespacedName{Namespace: instance.Spec.InstanceNamespace, Name: service.GetResourceName(instance)}
+	if err := res.RolloutRestartDeployment(ctx, p.Client, deploymentNsName); err != nil {
+		return err
+	}
+	fmt.Fprintf(p.Out, "Deployment %s restarted\n", deploymentNsName)
+	return nil
+}
+
+// SetPaused pauses or resumes reconciliation of the instance by the operator
+func (p *Plugin) SetPaused(ctx context.Context, paused bool) error {
+	instance, err := p.getInstance(ctx)
+	if err != nil {
+		return err
+	}
+	value := ""
+	if paused {
+		value = "true"
+	}
+	if err := p.annotate(ctx, instance, service.PauseReconciliationAnnotation, value); err != nil {
+		return err
+	}
+	if paused {
+		fmt.Fprintf(p.Out, "Reconciliation of IBMLicensing %s paused\n", instance.Name)
+	} else {
+		fmt.Fprintf(p.Out, "Reconciliation of IBMLicensing %s resumed\n", instance.Name)
+	}
+	return nil
+}
+
+// Sets annotation of the instance with a merge patch, empty value removes the annotation
+func (p *Plugin) annotate(ctx context.Context, instance *operatorv1alpha1.IBMLicensing, key, value string) error {
+	patch := client.MergeFrom(instance.DeepCopy())
+	annotations := instance.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if value == "" {
+		delete(annotations, key)
+	} else {
+		annotations[key] = value
+	}
+	instance.SetAnnotations(annotations)
+	return p.Client.Patch(ctx, instance, patch)
+}
+
+// Tokens and certificates are generated again only by reconciliation, so they must not be rotated while it is paused
+func checkNotPaused(instance *operatorv1alpha1.IBMLicensing) error {
+	if service.IsReconciliationPaused(instance) {
+		return fmt.Errorf("reconciliation of IBMLicensing %s is paused, resume it with the resume command first", instance.Name)
+	}
+	return nil
+}
+
+// Missing optional APIs, f.e. routes outside of OpenShift, are treated as missing objects
+func isNotFound(err error) bool {
+	return apierrors.IsNotFound(err) || meta.IsNoMatchError(err)
+}
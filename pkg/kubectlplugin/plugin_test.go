@@ -0,0 +1,221 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package kubectlplugin
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources/service"
+)
+
+const (
+	SUCCESS = "✓"
+	FAIL    = "✗"
+
+	operatorNamespace = "ibm-licensing"
+)
+
+func newTestPlugin(t *testing.T, objects ...client.Object) (*Plugin, client.Client, *bytes.Buffer) {
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{clientgoscheme.AddToScheme, operatorv1alpha1.AddToScheme,
+		routev1.AddToScheme, gatewayv1.Install} {
+		if err := addToScheme(scheme); err != nil {
+			t.Fatal(err)
+		}
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	out := &bytes.Buffer{}
+	return &Plugin{Client: k8sClient, Out: out, OperatorNamespace: operatorNamespace}, k8sClient, out
+}
+
+func testInstances() (*operatorv1alpha1.IBMLicensing, *operatorv1alpha1.IBMLicensing) {
+	active := &operatorv1alpha1.IBMLicensing{
+		ObjectMeta: metav1.ObjectMeta{Name: "instance"},
+		Spec: operatorv1alpha1.IBMLicensingSpec{
+			IBMLicenseServiceBaseSpec: operatorv1alpha1.IBMLicenseServiceBaseSpec{
+				TokenRotation: &operatorv1alpha1.IBMLicensingTokenRotation{},
+			},
+		},
+		Status: operatorv1alpha1.IBMLicensingStatus{State: service.ActiveCRState},
+	}
+	inactive := &operatorv1alpha1.IBMLicensing{
+		ObjectMeta: metav1.ObjectMeta{Name: "other"},
+		Status:     operatorv1alpha1.IBMLicensingStatus{State: service.InactiveCRState},
+	}
+	return active, inactive
+}
+
+func TestTokenAndURL(t *testing.T) {
+	active, inactive := testInstances()
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: active.Spec.GetAPISecretTokenName(), Namespace: operatorNamespace},
+		Data:       map[string][]byte{service.APISecretTokenKeyName: []byte("api-token")},
+	}
+	route := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{Name: service.GetResourceName(active), Namespace: operatorNamespace},
+		Spec:       routev1.RouteSpec{Host: "licensing.apps.example.com"},
+	}
+	plugin, _, out := newTestPlugin(t, inactive, active, tokenSecret, route)
+
+	t.Log("Given the need to access License Service API of the active instance")
+	{
+		if err := plugin.Token(context.Background()); err == nil && out.String() == "api-token\n" {
+			t.Logf("\t%s\tShould print token of the active instance", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould print token of the active instance, got %q: %v", FAIL, out.String(), err)
+		}
+
+		out.Reset()
+		err := plugin.URL(context.Background())
+		if err == nil && strings.Contains(out.String(), "ibm-licensing-service-instance.ibm-licensing.svc.cluster.local") &&
+			strings.Contains(out.String(), "https://licensing.apps.example.com") {
+			t.Logf("\t%s\tShould print service and route URLs", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould print service and route URLs, got %q: %v", FAIL, out.String(), err)
+		}
+
+		plugin.InstanceName = "missing"
+		if err := plugin.Token(context.Background()); apierrors.IsNotFound(err) {
+			t.Logf("\t%s\tShould fail for missing instance selected by name", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould fail for missing instance selected by name, got %v", FAIL, err)
+		}
+	}
+}
+
+func TestAnnotations(t *testing.T) {
+	active, inactive := testInstances()
+	plugin, k8sClient, _ := newTestPlugin(t, active, inactive)
+	getActive := func() *operatorv1alpha1.IBMLicensing {
+		instance := &operatorv1alpha1.IBMLicensing{}
+		if err := k8sClient.Get(context.Background(), types.NamespacedName{Name: active.Name}, instance); err != nil {
+			t.Fatal(err)
+		}
+		return instance
+	}
+
+	t.Log("Given the need to control the instance with annotations")
+	{
+		if err := plugin.SetPaused(context.Background(), true); err == nil && service.IsReconciliationPaused(getActive()) {
+			t.Logf("\t%s\tShould pause reconciliation", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould pause reconciliation: %v", FAIL, err)
+		}
+		if err := plugin.SetPaused(context.Background(), false); err == nil && !service.IsReconciliationPaused(getActive()) {
+			t.Logf("\t%s\tShould resume reconciliation", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould resume reconciliation: %v", FAIL, err)
+		}
+
+		if err := plugin.SetPaused(context.Background(), true); err != nil {
+			t.Fatal(err)
+		}
+		if err := plugin.RotateTokens(context.Background()); err != nil && getActive().Annotations[service.RotateTokensAnnotation] == "" {
+			t.Logf("\t%s\tShould refuse to rotate tokens while reconciliation is paused", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould refuse to rotate tokens while reconciliation is paused", FAIL)
+		}
+		if err := plugin.SetPaused(context.Background(), false); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := plugin.RotateTokens(context.Background()); err == nil && getActive().Annotations[service.RotateTokensAnnotation] != "" {
+			t.Logf("\t%s\tShould trigger rotation of tokens", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould trigger rotation of tokens: %v", FAIL, err)
+		}
+		plugin.InstanceName = inactive.Name
+		if err := plugin.RotateTokens(context.Background()); err != nil {
+			t.Logf("\t%s\tShould fail to rotate tokens when rotation is not enabled", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould fail to rotate tokens when rotation is not enabled", FAIL)
+		}
+	}
+}
+
+func TestRotateCertificates(t *testing.T) {
+	active, _ := testInstances()
+	active.Spec.HTTPSCertsSource = operatorv1alpha1.CustomCertsSource
+	secret := func(name string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: operatorNamespace}}
+	}
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: service.GetResourceName(active), Namespace: operatorNamespace}}
+	plugin, k8sClient, _ := newTestPlugin(t, active, deployment,
+		secret(service.LicenseServiceInternalCertName), secret(service.LicenseServiceExternalCertName))
+
+	t.Log("Given the need to regenerate certificates of License Service")
+	{
+		if err := plugin.SetPaused(context.Background(), true); err != nil {
+			t.Fatal(err)
+		}
+		err := plugin.RotateCertificates(context.Background())
+		kept := &corev1.Secret{}
+		getErr := k8sClient.Get(context.Background(), types.NamespacedName{Namespace: operatorNamespace, Name: service.LicenseServiceInternalCertName}, kept)
+		if err != nil && getErr == nil {
+			t.Logf("\t%s\tShould refuse to rotate certificates while reconciliation is paused", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould refuse to rotate certificates while reconciliation is paused: %v, %v", FAIL, err, getErr)
+		}
+		if err := plugin.SetPaused(context.Background(), false); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := plugin.RotateCertificates(context.Background()); err != nil {
+			t.Fatalf("\t%s\tShould rotate certificates without an error: %v", FAIL, err)
+		}
+		internal := &corev1.Secret{}
+		err = k8sClient.Get(context.Background(), types.NamespacedName{Namespace: operatorNamespace, Name: service.LicenseServiceInternalCertName}, internal)
+		if apierrors.IsNotFound(err) {
+			t.Logf("\t%s\tShould delete generated certificate", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould delete generated certificate, got %v", FAIL, err)
+		}
+		external := &corev1.Secret{}
+		err = k8sClient.Get(context.Background(), types.NamespacedName{Namespace: operatorNamespace, Name: service.LicenseServiceExternalCertName}, external)
+		if err == nil {
+			t.Logf("\t%s\tShould keep external certificate when custom certificates are used", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould keep external certificate when custom certificates are used, got %v", FAIL, err)
+		}
+
+		restarted := &appsv1.Deployment{}
+		if err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(deployment), restarted); err != nil {
+			t.Fatal(err)
+		}
+		if _, found := restarted.Spec.Template.Annotations["kubectl.kubernetes.io/restartedAt"]; found {
+			t.Logf("\t%s\tShould restart License Service", SUCCESS)
+		} else {
+			t.Errorf("\t%s\tShould restart License Service", FAIL)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+/*
+Package mustgather collects diagnostics of License Service into a gzipped tarball, which can be attached to a support
+case. Values of Secrets are never written, only their keys and metadata of certificates they contain.
+*/
+package mustgather
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	routev1 "github.com/openshift/api/route/v1"
+	operatorframeworkv1 "github.com/operator-framework/api/pkg/operators/v1"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+	res "github.com/IBM/ibm-licensing-operator/controllers/resources"
+	"github.com/IBM/ibm-licensing-operator/controllers/resources/service"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/api/v1alpha1"
+)
+
+const (
+	// Directory in the tarball containing all collected files
+	BundleRoot = "ibm-licensing-must-gather"
+	// File in the tarball listing resources, which could not be collected
+	ErrorsFileName = "errors.txt"
+)
+
+// Kind of namespaced objects collected by the release label of License Service
+type labeledKind struct {
+	directory string
+	list      func() client.ObjectList
+	// Returns false if the kind is not available in the cluster
+	available func(res.Capabilities) bool
+}
+
+var labeledKinds = []labeledKind{
+	{"deployments", func() client.ObjectList { return &appsv1.DeploymentList{} }, nil},
+	{"pods", func() client.ObjectList { return &corev1.PodList{} }, nil},
+	{"services", func() client.ObjectList { return &corev1.ServiceList{} }, nil},
+	{"configmaps", func() client.ObjectList { return &corev1.ConfigMapList{} }, nil},
+	{"secrets", func() client.ObjectList { return &corev1.SecretList{} }, nil},
+	{"networkpolicies", func() client.ObjectList { return &networkingv1.NetworkPolicyList{} }, nil},
+	{"servicemonitors", func() client.ObjectList { return &monitoringv1.ServiceMonitorList{} }, nil},
+	{"routes", func() client.ObjectList { return &routev1.RouteList{} }, func(c res.Capabilities) bool { return c.RouteAPI }},
+}
+
+// Gatherer collects diagnostics of License Service using the given client, which may be a fake client in tests
+type Gatherer struct {
+	Client            client.Client
+	Capabilities      res.Capabilities
+	OperatorNamespace string
+}
+
+// Tarball being written, errors of collecting single resources are recorded and do not stop the collection
+type bundle struct {
+	tarWriter *tar.Writer
+	modTime   time.Time
+	errors    []string
+	// Names of variables from spec.envVariable of instances, which are passed to License Service containers
+	envVariableNames sets.Set[string]
+}
+
+func (b *bundle) addFile(name string, content []byte) error {
+	header := &tar.Header{
+		Name:    path.Join(BundleRoot, name),
+		Mode:    0644,
+		Size:    int64(len(content)),
+		ModTime: b.modTime,
+	}
+	if err := b.tarWriter.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := b.tarWriter.Write(content)
+	return err
+}
+
+func (b *bundle) addYAML(name string, obj any) error {
+	content, err := yaml.Marshal(obj)
+	if err != nil {
+		b.addError(name, err)
+		return nil
+	}
+	return b.addFile(name, content)
+}
+
+func (b *bundle) addError(what string, err error) {
+	b.errors = append(b.errors, fmt.Sprintf("%s: %v", what, err))
+}
+
+// Gather writes the tarball to out. Only errors of writing the tarball are returned.
+func (g *Gatherer) Gather(ctx context.Context, out io.Writer) error {
+	gzipWriter := gzip.NewWriter(out)
+	b := &bundle{tarWriter: tar.NewWriter(gzipWriter), modTime: time.Now(), envVariableNames: sets.New[string]()}
+
+	steps := []func(context.Context, *bundle) error{
+		g.gatherCapabilities,
+		g.gatherOperatorGroups,
+		g.gatherOperandRequests,
+	}
+	namespaces, err := g.gatherInstances(ctx, b)
+	if err != nil {
+		return err
+	}
+	for _, namespace := range namespaces {
+		steps = append(steps,
+			func(ctx context.Context, b *bundle) error { return g.gatherLabeledObjects(ctx, b, namespace) },
+			func(ctx context.Context, b *bundle) error { return g.gatherEvents(ctx, b, namespace) },
+		)
+	}
+	steps = append(steps, g.gatherOperatorConfigMaps)
+	for _, step := range steps {
+		if err := step(ctx, b); err != nil {
+			return err
+		}
+	}
+
+	if len(b.errors) > 0 {
+		if err := b.addFile(ErrorsFileName, []byte(strings.Join(b.errors, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+	if err := b.tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+func (g *Gatherer) gatherCapabilities(_ context.Context, b *bundle) error {
+	return b.addYAML("capabilities.yaml", map[string]any{
+		"capabilities": g.Capabilities,
+		"ocpCluster":   g.Capabilities.IsOCPCluster(),
+	})
+}
+
+// Writes IBMLicensing instances with status and returns namespaces of the operator and instances
+func (g *Gatherer) gatherInstances(ctx context.Context, b *bundle) ([]string, error) {
+	namespaces := []string{g.OperatorNamespace}
+	instanceList := operatorv1alpha1.IBMLicensingList{}
+	if err := g.Client.List(ctx, &instanceList); err != nil {
+		b.addError("ibmlicensings", err)
+		return namespaces, nil
+	}
+	for i := range instanceList.Items {
+		instance := &instanceList.Items[i]
+		b.envVariableNames.Insert(slices.Collect(maps.Keys(instance.Spec.EnvVariable))...)
+		if err := b.addYAML("ibmlicensings/"+instance.Name+".yaml", g.sanitize(b, instance)); err != nil {
+			return nil, err
+		}
+		if instance.Spec.InstanceNamespace != "" && !slices.Contains(namespaces, instance.Spec.InstanceNamespace) {
+			namespaces = append(namespaces, instance.Spec.InstanceNamespace)
+		}
+	}
+	return namespaces, nil
+}
+
+// Writes objects labeled by the release label of License Service and certificate Secrets created by other components
+func (g *Gatherer) gatherLabeledObjects(ctx context.Context, b *bundle, namespace string) error {
+	selector := labels.SelectorFromSet(labels.Set{res.LicensingReleaseLabelKey: res.LicensingReleaseLabelValue})
+	var secrets []*corev1.Secret
+	for _, kind := range labeledKinds {
+		if kind.available != nil && !kind.available(g.Capabilities) {
+			continue
+		}
+		list := kind.list()
+		if err := g.Client.List(ctx, list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			if !meta.IsNoMatchError(err) {
+				b.addError(path.Join("namespaces", namespace, kind.directory), err)
+			}
+			continue
+		}
+		err := meta.EachListItem(list, func(obj runtime.Object) error {
+			if secret, isSecret := obj.(*corev1.Secret); isSecret {
+				secrets = append(secrets, secret)
+				return nil
+			}
+			object := obj.(client.Object)
+			return b.addYAML(path.Join("namespaces", namespace, kind.directory, object.GetName()+".yaml"), g.sanitize(b, object))
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Certificates created by OpenShift service CA or provided by users do not have the release label
+	for _, name := range g.certificateSecretNames(ctx) {
+		if slices.ContainsFunc(secrets, func(secret *corev1.Secret) bool { return secret.Name == name }) {
+			continue
+		}
+		secret := &corev1.Secret{}
+		if err := g.Client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+			if !apierrors.IsNotFound(err) {
+				b.addError(path.Join("namespaces", namespace, "secrets", name), err)
+			}
+			continue
+		}
+		secrets = append(secrets, secret)
+	}
+
+	for _, secret := range secrets {
+		if err := b.addYAML(path.Join("namespaces", namespace, "secrets", secret.Name+".yaml"), g.sanitize(b, RedactSecret(secret))); err != nil {
+			return err
+		}
+		if certificates := GetCertificateMetadata(secret); len(certificates) > 0 {
+			if err := b.addYAML(path.Join("namespaces", namespace, "certificates", secret.Name+".yaml"), certificates); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns names of Secrets with certificates used by License Service
+func (g *Gatherer) certificateSecretNames(ctx context.Context) []string {
+	names := []string{
+		service.LicenseServiceInternalCertName,
+		service.LicenseServiceExternalCertName,
+		service.LicenseServiceCustomExternalCertName,
+		service.PrometheusServiceOCPCertName,
+	}
+	instanceList := operatorv1alpha1.IBMLicensingList{}
+	if err := g.Client.List(ctx, &instanceList); err == nil {
+		for _, instance := range instanceList.Items {
+			if instance.Spec.Sender != nil && instance.Spec.Sender.ReporterCertsSecretName != "" &&
+				!slices.Contains(names, instance.Spec.Sender.ReporterCertsSecretName) {
+				names = append(names, instance.Spec.Sender.ReporterCertsSecretName)
+			}
+		}
+	}
+	return names
+}
+
+func (g *Gatherer) gatherOperatorGroups(ctx context.Context, b *bundle) error {
+	operatorGroupList := operatorframeworkv1.OperatorGroupList{}
+	if err := g.Client.List(ctx, &operatorGroupList, client.InNamespace(g.OperatorNamespace)); err != nil {
+		if !meta.IsNoMatchError(err) {
+			b.addError("operatorgroups", err)
+		}
+		return nil
+	}
+	for i := range operatorGroupList.Items {
+		operatorGroup := &operatorGroupList.Items[i]
+		if err := b.addYAML(path.Join("namespaces", g.OperatorNamespace, "operatorgroups", operatorGroup.Name+".yaml"), g.sanitize(b, operatorGroup)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Writes OperandRequests from all namespaces, which request ibm-licensing-operator
+func (g *Gatherer) gatherOperandRequests(ctx context.Context, b *bundle) error {
+	operandRequestList := odlm.OperandRequestList{}
+	if err := g.Client.List(ctx, &operandRequestList); err != nil {
+		if !meta.IsNoMatchError(err) {
+			b.addError("operandrequests", err)
+		}
+		return nil
+	}
+	for i := range operandRequestList.Items {
+		operandRequest := &operandRequestList.Items[i]
+		if !res.HasOperandRequestBindingForLicensing(*operandRequest) {
+			continue
+		}
+		name := path.Join("namespaces", operandRequest.Namespace, "operandrequests", operandRequest.Name+".yaml")
+		if err := b.addYAML(name, g.sanitize(b, operandRequest)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Writes events of the namespace sorted by time of the last occurrence
+func (g *Gatherer) gatherEvents(ctx context.Context, b *bundle, namespace string) error {
+	eventList := corev1.EventList{}
+	if err := g.Client.List(ctx, &eventList, client.InNamespace(namespace)); err != nil {
+		b.addError(path.Join("namespaces", namespace, "events"), err)
+		return nil
+	}
+	slices.SortStableFunc(eventList.Items, func(a, b corev1.Event) int {
+		return a.LastTimestamp.Time.Compare(b.LastTimestamp.Time)
+	})
+	for i := range eventList.Items {
+		eventList.Items[i].ManagedFields = nil
+	}
+	return b.addYAML(path.Join("namespaces", namespace, "events.yaml"), eventList.Items)
+}
+
+// Writes ConfigMaps with state and configuration of the operator, which do not have the release label
+func (g *Gatherer) gatherOperatorConfigMaps(ctx context.Context, b *bundle) error {
+	for _, name := range []string{res.OperatorGroupAuditConfigMapName, res.OLMMigrationStatusConfigMapName, res.OperatorConfigConfigMapName} {
+		configMap := &corev1.ConfigMap{}
+		if err := g.Client.Get(ctx, types.NamespacedName{Namespace: g.OperatorNamespace, Name: name}, configMap); err != nil {
+			if !apierrors.IsNotFound(err) {
+				b.addError(path.Join("namespaces", g.OperatorNamespace, "configmaps", name), err)
+			}
+			continue
+		}
+		if err := b.addYAML(path.Join("namespaces", g.OperatorNamespace, "configmaps", name+".yaml"), g.sanitize(b, configMap)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+Returns copy of object without managed fields and last applied configuration, which may contain Secret data, and with
+credentials removed from URLs in container environment and IBMLicensing proxy settings. Values of variables from
+spec.envVariable are removed from both the instances and the containers. Kind is set, as it is not returned by the
+client for typed objects.
+*/
+func (g *Gatherer) sanitize(b *bundle, obj client.Object) client.Object {
+	sanitized := obj.DeepCopyObject().(client.Object)
+	if gvk, err := apiutil.GVKForObject(obj, g.Client.Scheme()); err == nil {
+		sanitized.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+	sanitized.SetManagedFields(nil)
+	if annotations := sanitized.GetAnnotations(); annotations != nil {
+		delete(annotations, corev1.LastAppliedConfigAnnotation)
+		sanitized.SetAnnotations(annotations)
+	}
+	switch typed := sanitized.(type) {
+	case *appsv1.Deployment:
+		redactContainers(typed.Spec.Template.Spec.Containers, b.envVariableNames)
+		redactContainers(typed.Spec.Template.Spec.InitContainers, b.envVariableNames)
+	case *corev1.Pod:
+		redactContainers(typed.Spec.Containers, b.envVariableNames)
+		redactContainers(typed.Spec.InitContainers, b.envVariableNames)
+	case *operatorv1alpha1.IBMLicensing:
+		redactInstance(typed)
+	}
+	return sanitized
+}
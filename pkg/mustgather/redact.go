@@ -0,0 +1,169 @@
+//
+// Copyright 2026 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package mustgather
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	operatorv1alpha1 "github.com/IBM/ibm-licensing-operator/api/v1alpha1"
+)
+
+// RedactedValue replaces values, which must not leave the cluster
+const RedactedValue = "REDACTED"
+
+// CertificateMetadata describes a certificate found in a Secret, without the certificate itself
+type CertificateMetadata struct {
+	Key          string    `json:"key"`
+	Subject      string    `json:"subject"`
+	Issuer       string    `json:"issuer"`
+	SerialNumber string    `json:"serialNumber"`
+	NotBefore    time.Time `json:"notBefore"`
+	NotAfter     time.Time `json:"notAfter"`
+	DNSNames     []string  `json:"dnsNames,omitempty"`
+	IsCA         bool      `json:"isCA,omitempty"`
+}
+
+/*
+RedactSecret returns copy of Secret with values replaced by their size. Values are moved to stringData, so that the
+redacted content is readable in the bundle.
+*/
+func RedactSecret(secret *corev1.Secret) *corev1.Secret {
+	redacted := secret.DeepCopy()
+	redacted.Data = nil
+	redacted.StringData = nil
+	keys := sets.New[string]()
+	for key := range secret.Data {
+		keys.Insert(key)
+	}
+	for key := range secret.StringData {
+		keys.Insert(key)
+	}
+	if keys.Len() > 0 {
+		redacted.StringData = map[string]string{}
+	}
+	for _, key := range sets.List(keys) {
+		size := len(secret.Data[key])
+		if value, found := secret.StringData[key]; found {
+			size = len(value)
+		}
+		redacted.StringData[key] = fmt.Sprintf("%s (%d bytes)", RedactedValue, size)
+	}
+	return redacted
+}
+
+// GetCertificateMetadata returns metadata of PEM encoded certificates from Secret keys with .crt or .pem suffix
+func GetCertificateMetadata(secret *corev1.Secret) []CertificateMetadata {
+	var certificates []CertificateMetadata
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		if !strings.HasSuffix(key, ".crt") && !strings.HasSuffix(key, ".pem") {
+			continue
+		}
+		rest := secret.Data[key]
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				continue
+			}
+			certificates = append(certificates, CertificateMetadata{
+				Key:          key,
+				Subject:      certificate.Subject.String(),
+				Issuer:       certificate.Issuer.String(),
+				SerialNumber: certificate.SerialNumber.String(),
+				NotBefore:    certificate.NotBefore,
+				NotAfter:     certificate.NotAfter,
+				DNSNames:     certificate.DNSNames,
+				IsCA:         certificate.IsCA,
+			})
+		}
+	}
+	return certificates
+}
+
+/*
+Removes credentials from URLs in environment variables, f.e. from proxy settings. Values of variables with given names,
+which come from spec.envVariable of instances, are removed completely, except for proxy settings.
+*/
+func redactContainers(containers []corev1.Container, envVariableNames sets.Set[string]) {
+	for i := range containers {
+		for j := range containers[i].Env {
+			envVar := &containers[i].Env[j]
+			envVar.Value = redactEnvVariable(envVar.Name, envVar.Value, envVariableNames.Has(envVar.Name))
+		}
+	}
+}
+
+// Returns value of environment variable without credentials, free-form values from spec.envVariable are removed
+func redactEnvVariable(name, value string, fromEnvVariable bool) string {
+	switch {
+	case value == "":
+		return value
+	case slices.Contains([]string{"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY"}, strings.ToUpper(name)):
+		return redactURLCredentials(value)
+	case fromEnvVariable:
+		return RedactedValue
+	default:
+		return redactURLCredentials(value)
+	}
+}
+
+/*
+Removes credentials from proxy URLs of IBMLicensing instance. Values of envVariable are free-form and may contain
+credentials in any format, so only proxy settings are kept there, also without URL credentials.
+*/
+func redactInstance(instance *operatorv1alpha1.IBMLicensing) {
+	if outbound := instance.Spec.OutboundNetwork; outbound != nil {
+		outbound.HTTPProxy = redactURLCredentials(outbound.HTTPProxy)
+		outbound.HTTPSProxy = redactURLCredentials(outbound.HTTPSProxy)
+	}
+	for name, value := range instance.Spec.EnvVariable {
+		instance.Spec.EnvVariable[name] = redactEnvVariable(name, value, true)
+	}
+}
+
+func redactURLCredentials(value string) string {
+	if !strings.Contains(value, "@") {
+		return value
+	}
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.User == nil {
+		return value
+	}
+	parsed.User = url.User(RedactedValue)
+	return parsed.String()
+}